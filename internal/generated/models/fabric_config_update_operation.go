@@ -20,7 +20,7 @@ import (
 type FabricConfigUpdateOperation struct {
 
 	// payload
-	Payload []int64 `json:"payload"`
+	Payload any `json:"payload,omitempty"`
 
 	// type
 	Type FabricConfigUpdateOperationType `json:"type,omitempty"`
@@ -50,6 +50,9 @@ type HandlerVaultConfigHandler struct {
 	// Example: docker
 	Mode string `json:"mode,omitempty"`
 
+	// mount
+	Mount string `json:"mount,omitempty"`
+
 	// namespace
 	// Example: admin
 	Namespace string `json:"namespace,omitempty"`
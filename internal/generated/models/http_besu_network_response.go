@@ -21,7 +21,7 @@ type HTTPBesuNetworkResponse struct {
 	ChainID int64 `json:"chainId,omitempty"`
 
 	// config
-	Config []int64 `json:"config"`
+	Config any `json:"config,omitempty"`
 
 	// created at
 	CreatedAt string `json:"createdAt,omitempty"`
@@ -60,7 +60,7 @@ type HTTPConfigUpdateOperationRequest struct {
 	// @Description - UpdateOrdererOrgMSPPayload when type is "update_orderer_org_msp"
 	// @Description - UpdateApplicationACLPayload when type is "update_application_acl"
 	// Required: true
-	Payload []int64 `json:"payload"`
+	Payload any `json:"payload"`
 
 	// Type is the type of configuration update operation
 	// enum: add_org,remove_org,update_org_msp,set_anchor_peers,add_consenter,remove_consenter,update_consenter,update_etcd_raft_options,update_batch_size,update_batch_timeout,update_application_policy,update_orderer_policy,update_channel_policy,update_channel_capability,update_orderer_capability,update_application_capability,add_orderer_org,remove_orderer_org,update_orderer_org_msp,update_application_acl
@@ -88,8 +88,8 @@ func (m *HTTPConfigUpdateOperationRequest) Validate(formats strfmt.Registry) err
 
 func (m *HTTPConfigUpdateOperationRequest) validatePayload(formats strfmt.Registry) error {
 
-	if err := validate.Required("payload", "body", m.Payload); err != nil {
-		return err
+	if m.Payload == nil {
+		return errors.Required("payload", "body", nil)
 	}
 
 	return nil
@@ -48,6 +48,9 @@ type HTTPCreateBackupTargetRequest struct {
 	// @Example "us-east-1"
 	Region string `json:"region,omitempty"`
 
+	// Password used by Restic to encrypt the backup repository
+	ResticPassword string `json:"resticPassword,omitempty"`
+
 	// AWS secret key (required for S3 type)
 	SecretKey string `json:"secretKey,omitempty"`
 
@@ -18,7 +18,7 @@ import (
 type HTTPNetworkResponse struct {
 
 	// config
-	Config []int64 `json:"config"`
+	Config any `json:"config,omitempty"`
 
 	// created at
 	CreatedAt string `json:"createdAt,omitempty"`
@@ -30,7 +30,7 @@ type HTTPNetworkResponse struct {
 	CurrentConfigBlock string `json:"currentConfigBlock,omitempty"`
 
 	// deployment config
-	DeploymentConfig []int64 `json:"deploymentConfig"`
+	DeploymentConfig any `json:"deploymentConfig,omitempty"`
 
 	// description
 	Description string `json:"description,omitempty"`
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	apiclient "github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+// newAPIClient builds the generated go-swagger client for baseURL on top of
+// httpClient, whose transport takes care of authentication and retries.
+func newAPIClient(baseURL string, httpClient *http.Client) *apiclient.ChainLaunchAPI {
+	host, basePath, schemes := apiclient.DefaultHost, apiclient.DefaultBasePath, apiclient.DefaultSchemes
+	if u, err := url.Parse(baseURL); err == nil && u.Host != "" {
+		host = u.Host
+		basePath = path.Join("/", u.Path, apiclient.DefaultBasePath)
+		schemes = []string{u.Scheme}
+	}
+
+	transport := httptransport.NewWithClient(host, basePath, schemes, httpClient)
+	return apiclient.New(transport, strfmt.Default)
+}
+
+// parseID converts a Terraform string ID into the numeric ID used by the API.
+func parseID(id string) (int64, error) {
+	v, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q: expected a number", id)
+	}
+	return v, nil
+}
+
+// stringValueOrNull maps the empty string the API uses for unset fields to null.
+func stringValueOrNull(v string) types.String {
+	if v == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(v)
+}
+
+// refreshDuration returns the live duration, keeping the current spelling when
+// both describe the same duration (for example 60s and 1m0s). An empty live
+// value, which the API reports for a duration it does not know, keeps the
+// current one.
+func refreshDuration(current types.String, live string) types.String {
+	if live == "" {
+		return current
+	}
+	if !current.IsNull() && !current.IsUnknown() && equivalentDurations(current.ValueString(), live) {
+		return current
+	}
+	return types.StringValue(live)
+}
+
+// decodeConfigJSON decodes the JSON held by a config attribute into the
+// generated model target. An empty attribute leaves target untouched.
+func decodeConfigJSON(config types.String, target interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.IsNull() || config.IsUnknown() || config.ValueString() == "" {
+		return diags
+	}
+	if err := json.Unmarshal([]byte(config.ValueString()), target); err != nil {
+		diags.AddAttributeError(tfpath.Root("config"), "Config Parse Error", fmt.Sprintf("Unable to parse config JSON: %s", err))
+	}
+	return diags
+}
+
+// findNode pages through the nodes of a platform and returns the first one
+// accepted by match, or nil when there is none.
+func (c *Client) findNode(ctx context.Context, platform models.TypesBlockchainPlatform, match func(*models.HTTPNodeResponse) bool) (*models.HTTPNodeResponse, error) {
+	filter := string(platform)
+	for page := int64(1); ; page++ {
+		params := nodes.NewListNodesParamsWithContext(ctx).WithPlatform(&filter).WithPage(&page)
+		list, err := c.API.Nodes.ListNodes(params, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range list.Payload.Items {
+			if node != nil && match(node) {
+				return node, nil
+			}
+		}
+		if !list.Payload.HasNextPage {
+			return nil, nil
+		}
+	}
+}
+
+// createNodeRequest is the body of POST /nodes. The generated
+// models.HTTPCreateNodeRequest embeds every platform configuration by value, so
+// it would send an empty object for each platform other than the one being
+// created; this envelope reuses the generated configuration models instead.
+type createNodeRequest struct {
+	Name               string                           `json:"name"`
+	BlockchainPlatform models.TypesBlockchainPlatform   `json:"blockchainPlatform"`
+	FabricPeer         *models.TypesFabricPeerConfig    `json:"fabricPeer,omitempty"`
+	FabricOrderer      *models.TypesFabricOrdererConfig `json:"fabricOrderer,omitempty"`
+	BesuNode           *models.TypesBesuNodeConfig      `json:"besuNode,omitempty"`
+}
+
+// updateNodeRequest is the body of PUT /nodes/{id}, for the same reason as
+// createNodeRequest.
+type updateNodeRequest struct {
+	Name               string                            `json:"name,omitempty"`
+	BlockchainPlatform models.TypesBlockchainPlatform    `json:"blockchainPlatform,omitempty"`
+	FabricPeer         *updateFabricPeerRequest          `json:"fabricPeer,omitempty"`
+	FabricOrderer      *updateFabricOrdererRequest       `json:"fabricOrderer,omitempty"`
+	BesuNode           *models.HTTPUpdateBesuNodeRequest `json:"besuNode,omitempty"`
+}
+
+// updateFabricPeerRequest is the fabricPeer section of PUT /nodes/{id}. The
+// generated models.HTTPUpdateFabricPeerRequest omits an empty env, which
+// would leave the environment variables of the node untouched once removed.
+type updateFabricPeerRequest struct {
+	models.HTTPUpdateFabricPeerRequest
+	Env map[string]string `json:"env"`
+}
+
+// updateFabricOrdererRequest is the fabricOrderer section of PUT /nodes/{id},
+// for the same reason as updateFabricPeerRequest.
+type updateFabricOrdererRequest struct {
+	models.HTTPUpdateFabricOrdererRequest
+	Env map[string]string `json:"env"`
+}
+
+// createFabricNetworkRequest is the body of POST /networks/fabric, using
+// FabricNetworkConfig in place of models.HTTPFabricNetworkConfig.
+type createFabricNetworkRequest struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Config      FabricNetworkConfig `json:"config"`
+}
+
+// updateNetworkRequest is the body of PUT /networks/{type}/{id}, which the
+// generated client does not describe.
+type updateNetworkRequest struct {
+	Name   string                 `json:"name"`
+	Type   string                 `json:"type"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+// notificationProviderRequest is the body of POST /notifications/providers and
+// PUT /notifications/providers/{id}. It keeps the snake_case flag names the
+// provider has always sent, which models.HTTPCreateProviderRequest and
+// models.HTTPUpdateProviderRequest spell in camelCase.
+type notificationProviderRequest struct {
+	Name                string      `json:"name"`
+	Type                string      `json:"type"`
+	IsDefault           bool        `json:"is_default"`
+	NotifyBackupSuccess bool        `json:"notify_backup_success"`
+	NotifyBackupFailure bool        `json:"notify_backup_failure"`
+	NotifyNodeDowntime  bool        `json:"notify_node_downtime"`
+	NotifyS3ConnIssue   bool        `json:"notify_s3_conn_issue"`
+	Config              interface{} `json:"config"`
+}
+
+// importOrganizationRequest is the body of POST /organizations/import. The
+// generated models.HandlerImportOrganizationRequest embeds the import data of
+// every source type by value, so it would send empty raw, Vault and AWS KMS
+// objects alongside the one being imported.
+type importOrganizationRequest struct {
+	Name         string                          `json:"name"`
+	MspID        string                          `json:"mspId"`
+	Description  string                          `json:"description,omitempty"`
+	ProviderID   int64                           `json:"providerId"`
+	SourceType   string                          `json:"sourceType"`
+	RawImport    *models.HandlerRawImportData    `json:"rawImport,omitempty"`
+	VaultImport  *models.HandlerVaultImportData  `json:"vaultImport,omitempty"`
+	AwsKmsImport *models.HandlerAWSKMSImportData `json:"awsKmsImport,omitempty"`
+}
+
+// permissionsResponse is the body of GET /permissions. The generated
+// models.AuthRolePermissionsInfo declares role as an object, while the API
+// returns the role name as a string.
+type permissionsResponse struct {
+	Permissions []*models.AuthPermissionInfo `json:"permissions"`
+	Roles       []rolePermissionsInfo        `json:"roles"`
+}
+
+// rolePermissionsInfo describes a role and the permissions it grants.
+type rolePermissionsInfo struct {
+	Role        models.AuthRole              `json:"role"`
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	Permissions []*models.AuthPermissionInfo `json:"permissions"`
+}
+
+// getPermissions fetches the permissions known to the API and the roles that grant them.
+func getPermissions(ctx context.Context, client *Client) (*permissionsResponse, error) {
+	body, err := client.DoRequest(ctx, "GET", "/permissions", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var permissions permissionsResponse
+	if err := json.Unmarshal(body, &permissions); err != nil {
+		return nil, fmt.Errorf("unable to parse permissions response: %w", err)
+	}
+	return &permissions, nil
+}
+
+// rolePermissionCodes returns the codes of the permissions granted by role.
+func (p *permissionsResponse) rolePermissionCodes(role models.AuthRole) []string {
+	codes := []string{}
+	for _, info := range p.Roles {
+		if info.Role != role {
+			continue
+		}
+		for _, permission := range info.Permissions {
+			if permission != nil {
+				codes = append(codes, permission.Code)
+			}
+		}
+	}
+	return codes
+}
+
+// updateKeyRequest is the body of PUT /keys/{id}, which the generated client
+// does not describe.
+type updateKeyRequest struct {
+	Description *string `json:"description,omitempty"`
+	IsCA        int     `json:"isCA"`
+}
+
+// createAPIKeyRequest is the body of POST /api-keys. The generated
+// models.AuthCreateAPIKeyRequest wraps role in a struct, which would send it
+// as an object instead of the role name.
+type createAPIKeyRequest struct {
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	Role        models.AuthRole         `json:"role"`
+	Permissions []models.AuthPermission `json:"permissions,omitempty"`
+	ExpiresIn   string                  `json:"expires_in,omitempty"`
+}
+
+// getPlugin fetches a plugin by name. The generated client declares the error
+// bodies of GET /plugins/{name} as plain strings, so the JSON errors the API
+// returns would surface as decode errors and hide a 404.
+func getPlugin(ctx context.Context, client *Client, name string) (*models.GithubComChainlaunchChainlaunchPkgPluginTypesPlugin, error) {
+	body, err := client.DoRequest(ctx, "GET", fmt.Sprintf("/plugins/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var plugin models.GithubComChainlaunchChainlaunchPkgPluginTypesPlugin
+	if err := json.Unmarshal(body, &plugin); err != nil {
+		return nil, fmt.Errorf("unable to parse plugin response: %w", err)
+	}
+	return &plugin, nil
+}
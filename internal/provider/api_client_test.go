@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRefreshDuration(t *testing.T) {
+	tests := []struct {
+		current types.String
+		live    string
+		want    types.String
+	}{
+		{types.StringValue("60s"), "1m0s", types.StringValue("60s")},
+		{types.StringValue("2s"), "2000ms", types.StringValue("2s")},
+		{types.StringValue("30s"), "1m", types.StringValue("1m")},
+		{types.StringNull(), "1m", types.StringValue("1m")},
+		{types.StringValue("1m"), "", types.StringValue("1m")},
+		{types.StringNull(), "", types.StringNull()},
+	}
+	for _, test := range tests {
+		if got := refreshDuration(test.current, test.live); !got.Equal(test.want) {
+			t.Errorf("refreshDuration(%s, %q) = %s, want %s", test.current, test.live, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+// Authentication methods for username/password credentials
+const (
+	// AuthMethodBasic sends the username and password with every request
+	AuthMethodBasic = "basic"
+	// AuthMethodSession logs in once through /auth/login and sends the session cookie
+	AuthMethodSession = "session"
+)
+
+// sessionCache holds the cookies of the current login session. It is shared by
+// every request of a client, so concurrent operations log in only once.
+type sessionCache struct {
+	mu      sync.Mutex
+	cookies []*http.Cookie
+}
+
+// authenticate adds the client's credentials to req. A bearer token takes
+// precedence, then username/password and finally the API key, which the API
+// accepts as a bearer token as well. With session authentication it logs in
+// first when no session is cached, and returns the cookies it used so that a
+// 401 response can invalidate exactly that session.
+func (t *apiTransport) authenticate(req *http.Request) ([]*http.Cookie, error) {
+	c := t.client
+	switch {
+	case c.Token != "":
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	case c.Username != "" && c.Password != "":
+		if c.AuthMethod != AuthMethodSession {
+			req.SetBasicAuth(c.Username, c.Password)
+			return nil, nil
+		}
+		cookies, err := t.sessionCookies(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		for _, cookie := range cookies {
+			req.AddCookie(cookie)
+		}
+		return cookies, nil
+	case c.APIKey != "":
+		req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	}
+	return nil, nil
+}
+
+// sessionCookies returns the cached session, logging in when there is none.
+func (t *apiTransport) sessionCookies(ctx context.Context) ([]*http.Cookie, error) {
+	s := &t.client.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cookies == nil {
+		cookies, err := t.login(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.cookies = cookies
+	}
+	return s.cookies, nil
+}
+
+// invalidateSession drops the cached session if it is still the one identified
+// by used, and reports whether a request sent with used is worth replaying.
+// A session replaced by another request in the meantime is kept.
+func (t *apiTransport) invalidateSession(used []*http.Cookie) bool {
+	if used == nil {
+		return false
+	}
+	s := &t.client.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.cookies) > 0 && &s.cookies[0] == &used[0] {
+		s.cookies = nil
+	}
+	return true
+}
+
+// login exchanges the username and password for a session cookie within the
+// deadline of the attempt that needs it. It goes straight to the base
+// transport, as the login request itself is not authenticated.
+func (t *apiTransport) login(ctx context.Context) ([]*http.Cookie, error) {
+	c := t.client
+	body, err := json.Marshal(&models.AuthLoginRequest{Username: c.Username, Password: c.Password})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling login request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/api/v1/auth/login", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating login request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("error logging in to Chainlaunch: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("login failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	cookies := resp.Cookies()
+	if len(cookies) == 0 {
+		return nil, fmt.Errorf("login succeeded but the response did not set a session cookie")
+	}
+	return cookies, nil
+}
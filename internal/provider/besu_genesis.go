@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BesuGenesis is the subset of a Besu genesis file that the provider checks
+// before importing a network.
+type BesuGenesis struct {
+	ChainID    int64
+	Consensus  string
+	Validators []string
+}
+
+// besuGenesisFile mirrors the JSON encoding of a Besu genesis file.
+type besuGenesisFile struct {
+	Config *struct {
+		ChainID *flexInt        `json:"chainId"`
+		QBFT    json.RawMessage `json:"qbft"`
+		IBFT2   json.RawMessage `json:"ibft2"`
+		Clique  json.RawMessage `json:"clique"`
+	} `json:"config"`
+	ExtraData string `json:"extraData"`
+}
+
+// Length of the vanity prefix and the clique seal in extraData
+const (
+	besuExtraVanityLength = 32
+	cliqueSealLength      = 65
+	ethAddressLength      = 20
+)
+
+// ParseBesuGenesis decodes a Besu genesis file and the initial validators
+// encoded in its extraData.
+func ParseBesuGenesis(raw []byte) (*BesuGenesis, error) {
+	var file besuGenesisFile
+	if err := json.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("unable to decode genesis: %w", err)
+	}
+	if file.Config == nil {
+		return nil, errors.New("genesis has no config section")
+	}
+	if file.Config.ChainID == nil {
+		return nil, errors.New("genesis config has no chainId")
+	}
+
+	genesis := &BesuGenesis{ChainID: int64(*file.Config.ChainID)}
+	switch {
+	case len(file.Config.QBFT) > 0:
+		genesis.Consensus = "qbft"
+	case len(file.Config.IBFT2) > 0:
+		genesis.Consensus = "ibft2"
+	case len(file.Config.Clique) > 0:
+		genesis.Consensus = "clique"
+	default:
+		return nil, errors.New("genesis config names no supported consensus (qbft, ibft2 or clique)")
+	}
+
+	extraData, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(file.ExtraData), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("extraData is not valid hex: %w", err)
+	}
+
+	var validators [][]byte
+	if genesis.Consensus == "clique" {
+		validators, err = cliqueValidators(extraData)
+	} else {
+		validators, err = bftValidators(extraData)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode validators from extraData: %w", err)
+	}
+	if len(validators) == 0 {
+		return nil, errors.New("extraData lists no validators")
+	}
+
+	for _, validator := range validators {
+		genesis.Validators = append(genesis.Validators, "0x"+hex.EncodeToString(validator))
+	}
+	return genesis, nil
+}
+
+// cliqueValidators extracts the signers of a clique extraData:
+// 32 bytes of vanity, the signer addresses and a 65 byte seal.
+func cliqueValidators(extraData []byte) ([][]byte, error) {
+	signers := len(extraData) - besuExtraVanityLength - cliqueSealLength
+	if signers < 0 || signers%ethAddressLength != 0 {
+		return nil, fmt.Errorf("clique extraData of %d bytes is not vanity, signer addresses and seal", len(extraData))
+	}
+	var validators [][]byte
+	for i := besuExtraVanityLength; i < besuExtraVanityLength+signers; i += ethAddressLength {
+		validators = append(validators, extraData[i:i+ethAddressLength])
+	}
+	return validators, nil
+}
+
+// bftValidators extracts the validators of a QBFT or IBFT 2.0 extraData, the
+// RLP list [vanity, validators, vote, round, seals].
+func bftValidators(extraData []byte) ([][]byte, error) {
+	fields, rest, err := rlpSplitList(extraData)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("trailing bytes after the RLP list")
+	}
+
+	// Skip the vanity, then decode the validator list
+	_, fields, err = rlpSplitString(fields)
+	if err != nil {
+		return nil, fmt.Errorf("vanity: %w", err)
+	}
+	list, _, err := rlpSplitList(fields)
+	if err != nil {
+		return nil, fmt.Errorf("validator list: %w", err)
+	}
+
+	var validators [][]byte
+	for len(list) > 0 {
+		var validator []byte
+		validator, list, err = rlpSplitString(list)
+		if err != nil {
+			return nil, fmt.Errorf("validator: %w", err)
+		}
+		if len(validator) != ethAddressLength {
+			return nil, fmt.Errorf("validator of %d bytes is not an address", len(validator))
+		}
+		validators = append(validators, validator)
+	}
+	return validators, nil
+}
+
+// rlpSplitString decodes an RLP string at the start of b and returns its
+// content and the bytes that follow it.
+func rlpSplitString(b []byte) (content, rest []byte, err error) {
+	isList, content, rest, err := rlpSplit(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isList {
+		return nil, nil, errors.New("expected an RLP string, got a list")
+	}
+	return content, rest, nil
+}
+
+// rlpSplitList decodes an RLP list at the start of b and returns its encoded
+// items and the bytes that follow it.
+func rlpSplitList(b []byte) (content, rest []byte, err error) {
+	isList, content, rest, err := rlpSplit(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isList {
+		return nil, nil, errors.New("expected an RLP list, got a string")
+	}
+	return content, rest, nil
+}
+
+// rlpSplit decodes the RLP item at the start of b.
+func rlpSplit(b []byte) (isList bool, content, rest []byte, err error) {
+	if len(b) == 0 {
+		return false, nil, nil, errors.New("unexpected end of RLP input")
+	}
+
+	prefix := b[0]
+	var offset, size int
+	switch {
+	case prefix < 0x80:
+		return false, b[:1], b[1:], nil
+	case prefix <= 0xb7:
+		offset, size = 1, int(prefix-0x80)
+	case prefix <= 0xbf:
+		offset, size, err = rlpLongSize(b, int(prefix-0xb7))
+	case prefix <= 0xf7:
+		isList, offset, size = true, 1, int(prefix-0xc0)
+	default:
+		isList = true
+		offset, size, err = rlpLongSize(b, int(prefix-0xf7))
+	}
+	if err != nil {
+		return false, nil, nil, err
+	}
+	if size > len(b)-offset {
+		return false, nil, nil, errors.New("RLP item is longer than its input")
+	}
+	return isList, b[offset : offset+size], b[offset+size:], nil
+}
+
+// rlpLongSize decodes the big-endian size that follows the prefix of a long RLP item.
+func rlpLongSize(b []byte, sizeLength int) (offset, size int, err error) {
+	if sizeLength > 4 || len(b) < 1+sizeLength {
+		return 0, 0, errors.New("invalid RLP item size")
+	}
+	for _, c := range b[1 : 1+sizeLength] {
+		size = size<<8 | int(c)
+	}
+	return 1 + sizeLength, size, nil
+}
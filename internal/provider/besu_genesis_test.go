@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// rlpEncode encodes strings ([]byte) and lists ([]interface{}) for the tests.
+func rlpEncode(item interface{}) []byte {
+	var content []byte
+	var short, long byte
+	switch v := item.(type) {
+	case []byte:
+		if len(v) == 1 && v[0] < 0x80 {
+			return v
+		}
+		content, short, long = v, 0x80, 0xb7
+	case []interface{}:
+		for _, element := range v {
+			content = append(content, rlpEncode(element)...)
+		}
+		short, long = 0xc0, 0xf7
+	}
+	if len(content) <= 55 {
+		return append([]byte{short + byte(len(content))}, content...)
+	}
+	var size []byte
+	for n := len(content); n > 0; n >>= 8 {
+		size = append([]byte{byte(n)}, size...)
+	}
+	return append(append([]byte{long + byte(len(size))}, size...), content...)
+}
+
+func testAddress(i int) []byte {
+	address := make([]byte, ethAddressLength)
+	address[ethAddressLength-1] = byte(i)
+	return address
+}
+
+func testGenesis(config, extraData string) []byte {
+	return []byte(fmt.Sprintf(`{"config": %s, "gasLimit": "0x1fffffffffffff", "extraData": %q, "alloc": {}}`, config, extraData))
+}
+
+func TestParseBesuGenesisQBFT(t *testing.T) {
+	validators := []interface{}{}
+	for i := 1; i <= 4; i++ {
+		validators = append(validators, testAddress(i))
+	}
+	extraData := rlpEncode([]interface{}{make([]byte, besuExtraVanityLength), validators, []byte{}, []byte{0, 0, 0, 0}, []interface{}{}})
+
+	genesis, err := ParseBesuGenesis(testGenesis(`{"chainId": 1337, "qbft": {"blockperiodseconds": 5}}`, "0x"+hex.EncodeToString(extraData)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if genesis.ChainID != 1337 || genesis.Consensus != "qbft" {
+		t.Errorf("unexpected chain ID %d or consensus %q", genesis.ChainID, genesis.Consensus)
+	}
+	if len(genesis.Validators) != 4 || genesis.Validators[3] != "0x"+strings.Repeat("00", 19)+"04" {
+		t.Errorf("unexpected validators %v", genesis.Validators)
+	}
+}
+
+func TestParseBesuGenesisClique(t *testing.T) {
+	extraData := make([]byte, besuExtraVanityLength)
+	extraData = append(extraData, testAddress(1)...)
+	extraData = append(extraData, testAddress(2)...)
+	extraData = append(extraData, make([]byte, cliqueSealLength)...)
+
+	genesis, err := ParseBesuGenesis(testGenesis(`{"chainId": "2024", "clique": {"blockperiodseconds": 15}}`, hex.EncodeToString(extraData)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if genesis.ChainID != 2024 || genesis.Consensus != "clique" || len(genesis.Validators) != 2 {
+		t.Errorf("unexpected genesis %+v", genesis)
+	}
+}
+
+func TestParseBesuGenesisErrors(t *testing.T) {
+	noValidators := hex.EncodeToString(rlpEncode([]interface{}{make([]byte, besuExtraVanityLength), []interface{}{}, []byte{}, []byte{}, []interface{}{}}))
+	truncated := hex.EncodeToString(rlpEncode([]interface{}{make([]byte, besuExtraVanityLength), []interface{}{testAddress(1)}})[:30])
+
+	tests := map[string][]byte{
+		"not JSON":           []byte("genesis"),
+		"missing config":     []byte(`{"extraData": "0x"}`),
+		"missing chain ID":   testGenesis(`{"qbft": {}}`, "0x"),
+		"missing consensus":  testGenesis(`{"chainId": 1}`, "0x"),
+		"invalid extraData":  testGenesis(`{"chainId": 1, "qbft": {}}`, "0xzz"),
+		"no validators":      testGenesis(`{"chainId": 1, "qbft": {}}`, noValidators),
+		"truncated RLP":      testGenesis(`{"chainId": 1, "ibft2": {}}`, truncated),
+		"clique without end": testGenesis(`{"chainId": 1, "clique": {}}`, hex.EncodeToString(make([]byte, 40))),
+	}
+	for name, raw := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseBesuGenesis(raw); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(url string) *Client {
+	client := NewClient(url, "", "test", "test")
+	client.RetryWaitMin = time.Millisecond
+	client.RetryMaxWait = 5 * time.Millisecond
+	return client
+}
+
+func TestDoRequestRetriesTransientStatus(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	body, err := newTestClient(server.URL).DoRequest(context.Background(), "POST", "/nodes", map[string]string{"name": "peer0"})
+	if err != nil {
+		t.Fatalf("expected request to succeed after retries, got: %s", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Errorf("unexpected body: %s", body)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestDoRequestDoesNotRetryUnsafePOST(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+
+	if _, err := client.DoRequest(context.Background(), "POST", "/nodes", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt for a non-idempotent POST, got %d", attempts)
+	}
+
+	atomic.StoreInt32(&attempts, 0)
+	if _, err := client.DoRequest(context.Background(), "POST", "/nodes/1/restart", nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != int32(client.MaxRetries+1) {
+		t.Errorf("expected %d attempts for a safe POST, got %d", client.MaxRetries+1, attempts)
+	}
+}
+
+func TestDoRequestDoesNotRetryClientErrors(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := newTestClient(server.URL).DoRequest(context.Background(), "GET", "/nodes/1", nil)
+	if !IsNotFoundError(err) {
+		t.Fatalf("expected a not found error, got: %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestDoRequestStopsRetryingWhenContextIsCancelled(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.RetryWaitMin = time.Minute
+	client.RetryMaxWait = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.DoRequest(ctx, "GET", "/nodes/1", nil)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("expected cancellation to interrupt the retry wait, took %s", elapsed)
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt before cancellation, got %d", attempts)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	client := NewClient("http://localhost", "", "", "")
+	client.RetryWaitMin = time.Second
+	client.RetryMaxWait = 10 * time.Second
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", "3")
+	if wait := client.retryWait(0, resp); wait != 3*time.Second {
+		t.Errorf("expected Retry-After to be honoured, got %s", wait)
+	}
+
+	resp.Header.Set("Retry-After", "120")
+	if wait := client.retryWait(0, resp); wait != client.RetryMaxWait {
+		t.Errorf("expected Retry-After to be capped at %s, got %s", client.RetryMaxWait, wait)
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := client.retryWait(attempt, nil)
+		if wait <= 0 || wait > client.RetryMaxWait {
+			t.Errorf("attempt %d: backoff %s outside (0, %s]", attempt, wait, client.RetryMaxWait)
+		}
+	}
+}
+
+func TestDoRequestRequestTimeoutAppliesWithinContextDeadline(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The first attempt hangs until the client gives up on it
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.RequestTimeout = 50 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := client.DoRequest(ctx, "GET", "/nodes/1", nil); err != nil {
+		t.Fatalf("expected the hung attempt to be retried, got: %s", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+
+	// The caller's deadline still wins when it comes first
+	client.MaxRetries = 0
+	client.RequestTimeout = 10 * time.Second
+	atomic.StoreInt32(&attempts, 0)
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.DoRequest(ctx, "GET", "/nodes/1", nil); err == nil {
+		t.Fatal("expected the context deadline to end the request")
+	}
+}
+
+func TestDoRequestReplaysBodyAndAuthenticatesRetries(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"peer0"}` {
+			t.Errorf("attempt %d: unexpected body %q", atomic.LoadInt32(&attempts)+1, body)
+		}
+		if user, _, ok := r.BasicAuth(); !ok || user != "test" {
+			t.Errorf("attempt %d: request is not authenticated", atomic.LoadInt32(&attempts)+1)
+		}
+		if atomic.AddInt32(&attempts, 1) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	if _, err := newTestClient(server.URL).DoRequest(context.Background(), "POST", "/nodes", map[string]string{"name": "peer0"}); err != nil {
+		t.Fatalf("expected request to succeed after a retry, got: %s", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestBearerAuthentication(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "my-api-key", "", "")
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "Bearer my-api-key" {
+		t.Errorf("expected the API key as a bearer token, got %q", got)
+	}
+
+	client.Token = "my-token"
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got != "Bearer my-token" {
+		t.Errorf("expected the token to take precedence, got %q", got)
+	}
+}
+
+func TestSessionAuthenticationLogsInOnceAndRenewsExpiredSessions(t *testing.T) {
+	var logins, requests int32
+	var session atomic.Value
+	session.Store("")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/auth/login" {
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"password":"secret","username":"admin"}` {
+				t.Errorf("unexpected login body %q", body)
+			}
+			id := fmt.Sprintf("session-%d", atomic.AddInt32(&logins, 1))
+			session.Store(id)
+			http.SetCookie(w, &http.Cookie{Name: "session_id", Value: id})
+			_, _ = w.Write([]byte(`{"message":"ok"}`))
+			return
+		}
+
+		atomic.AddInt32(&requests, 1)
+		if _, _, ok := r.BasicAuth(); ok {
+			t.Error("expected no basic auth with session authentication")
+		}
+		cookie, err := r.Cookie("session_id")
+		if err != nil || cookie.Value != session.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.Username, client.Password = "admin", "secret"
+	client.AuthMethod = AuthMethodSession
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i+1, err)
+		}
+	}
+	if logins != 1 {
+		t.Errorf("expected the session to be reused, got %d logins", logins)
+	}
+
+	// Expire the session on the server side
+	session.Store("expired")
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("expected the request to succeed after logging in again, got: %s", err)
+	}
+	if logins != 2 {
+		t.Errorf("expected a second login after the session expired, got %d logins", logins)
+	}
+	if requests != 4 {
+		t.Errorf("expected the rejected request to be replayed once, got %d requests", requests)
+	}
+}
+
+func TestSessionAuthenticationReportsLoginFailure(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/auth/login" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"invalid credentials"}`))
+			return
+		}
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+
+	client := newTestClient(server.URL)
+	client.AuthMethod = AuthMethodSession
+
+	_, err := client.DoRequest(context.Background(), "GET", "/nodes", nil)
+	if err == nil || !strings.Contains(err.Error(), "login failed with status 401") {
+		t.Fatalf("expected a login error, got: %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("expected no API request without a session, got %d", requests)
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/api_keys"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &APIKeyLogsDataSource{}
+
+func NewAPIKeyLogsDataSource() datasource.DataSource {
+	return &APIKeyLogsDataSource{}
+}
+
+// APIKeyLogsDataSource defines the data source implementation.
+type APIKeyLogsDataSource struct {
+	client *Client
+}
+
+// APIKeyLogItem represents a single usage log entry of an API key
+type APIKeyLogItem struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Action    types.String `tfsdk:"action"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	UserAgent types.String `tfsdk:"user_agent"`
+	Metadata  types.String `tfsdk:"metadata"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// APIKeyLogsDataSourceModel describes the data source data model.
+type APIKeyLogsDataSourceModel struct {
+	ID       types.String    `tfsdk:"id"`
+	APIKeyID types.Int64     `tfsdk:"api_key_id"`
+	Limit    types.Int64     `tfsdk:"limit"`
+	Logs     []APIKeyLogItem `tfsdk:"logs"`
+}
+
+func (d *APIKeyLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key_logs"
+}
+
+func (d *APIKeyLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the usage log of an API key, to audit which pipeline or plugin used it and from where.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Placeholder identifier for the data source.",
+			},
+			"api_key_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the API key.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The maximum number of log entries to return. Defaults to the API default.",
+			},
+			"logs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Usage log entries of the API key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The unique identifier of the log entry.",
+						},
+						"action": schema.StringAttribute{
+							Computed:    true,
+							Description: "What the key was used for (e.g., the request it authenticated).",
+						},
+						"ip_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address the request came from.",
+						},
+						"user_agent": schema.StringAttribute{
+							Computed:    true,
+							Description: "The user agent of the request.",
+						},
+						"metadata": schema.StringAttribute{
+							Computed:    true,
+							Description: "Additional details recorded with the entry, as JSON.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp of the entry.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *APIKeyLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *APIKeyLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data APIKeyLogsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := api_keys.NewGetAPIKeyLogsParamsWithContext(ctx).WithID(data.APIKeyID.ValueInt64())
+	if !data.Limit.IsNull() {
+		params = params.WithLimit(data.Limit.ValueInt64Pointer())
+	}
+
+	list, err := d.client.API.APIKeys.GetAPIKeyLogs(params, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key logs, got error: %s", err))
+		return
+	}
+
+	data.Logs = []APIKeyLogItem{}
+	for _, entry := range list.Payload {
+		if entry == nil {
+			continue
+		}
+		data.Logs = append(data.Logs, APIKeyLogItem{
+			ID:        types.Int64Value(entry.ID),
+			Action:    types.StringValue(entry.Action),
+			IPAddress: stringValueOrNull(entry.IPAddress),
+			UserAgent: stringValueOrNull(entry.UserAgent),
+			Metadata:  stringValueOrNull(entry.Metadata),
+			CreatedAt: stringValueOrNull(entry.CreatedAt),
+		})
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d", data.APIKeyID.ValueInt64()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/besu_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ datasource.DataSource = &BesuNetworkDataSource{}
@@ -98,43 +100,26 @@ func (d *BesuNetworkDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	// List all Besu networks and find the one matching the name
-	body, err := d.client.DoRequest("GET", "/networks/besu", nil)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Besu networks: %s", err))
-		return
-	}
-
-	var listResp struct {
-		Networks []struct {
-			ID          int64  `json:"id"`
-			Name        string `json:"name"`
-			Platform    string `json:"platform"`
-			Description string `json:"description"`
-			Status      string `json:"status"`
-			CreatedAt   string `json:"createdAt"`
-		} `json:"networks"`
-	}
-	if err := json.Unmarshal(body, &listResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return
-	}
-
-	// Find matching network
-	var found bool
-	for _, network := range listResp.Networks {
-		if network.Name == data.Name.ValueString() {
-			data.ID = types.Int64Value(network.ID)
-			data.Name = types.StringValue(network.Name)
-			data.Platform = types.StringValue(network.Platform)
-			data.Description = types.StringValue(network.Description)
-			data.Status = types.StringValue(network.Status)
-			data.CreatedAt = types.StringValue(network.CreatedAt)
-			found = true
+	var found *models.HTTPBesuNetworkResponse
+	for offset := int64(0); found == nil; {
+		list, err := d.client.API.BesuNetworks.ListBesuNetworks(besu_networks.NewListBesuNetworksParamsWithContext(ctx).WithOffset(&offset), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Besu networks: %s", err))
+			return
+		}
+		for _, network := range list.Payload.Networks {
+			if network != nil && network.Name == data.Name.ValueString() {
+				found = network
+				break
+			}
+		}
+		offset += int64(len(list.Payload.Networks))
+		if len(list.Payload.Networks) == 0 || offset >= list.Payload.Total {
 			break
 		}
 	}
 
-	if !found {
+	if found == nil {
 		resp.Diagnostics.AddError(
 			"Besu Network Not Found",
 			fmt.Sprintf("No Besu network found with name '%s'", data.Name.ValueString()),
@@ -142,5 +127,12 @@ func (d *BesuNetworkDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	data.ID = types.Int64Value(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.Platform = types.StringValue(found.Platform)
+	data.Description = types.StringValue(found.Description)
+	data.Status = types.StringValue(found.Status)
+	data.CreatedAt = types.StringValue(found.CreatedAt)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -200,65 +201,68 @@ func (d *BesuNodeDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	body, err := d.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Besu node, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var nodeResp BesuNodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu node response, got error: %s", err))
+	got, err := d.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Besu node, got error: %s", err))
 		return
 	}
+	nodeResp := got.Payload
 
 	data.Name = types.StringValue(nodeResp.Name)
-	if nodeResp.NetworkID > 0 {
-		data.NetworkID = types.Int64Value(nodeResp.NetworkID)
-	}
-	if nodeResp.KeyID > 0 {
-		data.KeyID = types.Int64Value(nodeResp.KeyID)
-	}
-	if nodeResp.Mode != "" {
-		data.Mode = types.StringValue(nodeResp.Mode)
-	}
-	if nodeResp.Version != "" {
-		data.Version = types.StringValue(nodeResp.Version)
-	}
-	if nodeResp.ExternalIP != "" {
-		data.ExternalIP = types.StringValue(nodeResp.ExternalIP)
-	}
-	if nodeResp.InternalIP != "" {
-		data.InternalIP = types.StringValue(nodeResp.InternalIP)
-	}
-	if nodeResp.P2PHost != "" {
-		data.P2PHost = types.StringValue(nodeResp.P2PHost)
-	}
-	if nodeResp.P2PPort > 0 {
-		data.P2PPort = types.Int64Value(nodeResp.P2PPort)
-	}
-	if nodeResp.RPCHost != "" {
-		data.RPCHost = types.StringValue(nodeResp.RPCHost)
-	}
-	if nodeResp.RPCPort > 0 {
-		data.RPCPort = types.Int64Value(nodeResp.RPCPort)
-	}
-	if nodeResp.MinGasPrice > 0 {
-		data.MinGasPrice = types.Int64Value(nodeResp.MinGasPrice)
-	}
-	if nodeResp.HostAllowList != "" {
-		data.HostAllowList = types.StringValue(nodeResp.HostAllowList)
-	}
-	data.MetricsEnabled = types.BoolValue(nodeResp.MetricsEnabled)
-	if nodeResp.MetricsPort > 0 {
-		data.MetricsPort = types.Int64Value(nodeResp.MetricsPort)
-	}
-	if nodeResp.MetricsProtocol != "" {
-		data.MetricsProtocol = types.StringValue(nodeResp.MetricsProtocol)
-	}
-	data.JWTEnabled = types.BoolValue(nodeResp.JWTEnabled)
-	if nodeResp.JWTAuthenticationAlgorithm != "" {
-		data.JWTAuthenticationAlgorithm = types.StringValue(nodeResp.JWTAuthenticationAlgorithm)
+	if besu := nodeResp.BesuNode; besu != nil {
+		if besu.NetworkID > 0 {
+			data.NetworkID = types.Int64Value(besu.NetworkID)
+		}
+		if besu.KeyID > 0 {
+			data.KeyID = types.Int64Value(besu.KeyID)
+		}
+		if besu.Mode != "" {
+			data.Mode = types.StringValue(besu.Mode)
+		}
+		if besu.Version != "" {
+			data.Version = types.StringValue(besu.Version)
+		}
+		if besu.ExternalIP != "" {
+			data.ExternalIP = types.StringValue(besu.ExternalIP)
+		}
+		if besu.InternalIP != "" {
+			data.InternalIP = types.StringValue(besu.InternalIP)
+		}
+		if besu.P2pHost != "" {
+			data.P2PHost = types.StringValue(besu.P2pHost)
+		}
+		if besu.P2pPort > 0 {
+			data.P2PPort = types.Int64Value(besu.P2pPort)
+		}
+		if besu.RPCHost != "" {
+			data.RPCHost = types.StringValue(besu.RPCHost)
+		}
+		if besu.RPCPort > 0 {
+			data.RPCPort = types.Int64Value(besu.RPCPort)
+		}
+		if besu.MinGasPrice > 0 {
+			data.MinGasPrice = types.Int64Value(besu.MinGasPrice)
+		}
+		if besu.HostAllowList != "" {
+			data.HostAllowList = types.StringValue(besu.HostAllowList)
+		}
+		data.MetricsEnabled = types.BoolValue(besu.MetricsEnabled)
+		if besu.MetricsPort > 0 {
+			data.MetricsPort = types.Int64Value(besu.MetricsPort)
+		}
+		if besu.MetricsProtocol != "" {
+			data.MetricsProtocol = types.StringValue(besu.MetricsProtocol)
+		}
+		data.JWTEnabled = types.BoolValue(besu.JwtEnabled)
+		if besu.JwtAuthenticationAlgorithm != "" {
+			data.JWTAuthenticationAlgorithm = types.StringValue(besu.JwtAuthenticationAlgorithm)
+		}
 	}
 	if nodeResp.Status != "" {
 		data.Status = types.StringValue(nodeResp.Status)
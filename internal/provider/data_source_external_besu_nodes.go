@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/external_nodes"
 )
 
 var _ datasource.DataSource = &ExternalBesuNodesDataSource{}
@@ -124,59 +125,28 @@ func (d *ExternalBesuNodesDataSource) Configure(ctx context.Context, req datasou
 func (d *ExternalBesuNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ExternalBesuNodesDataSourceModel
 
-	body, err := d.client.DoRequest("GET", "/external-nodes/besu-nodes", nil)
+	list, err := d.client.API.ExternalNodes.ListExternalBesuNodes(external_nodes.NewListExternalBesuNodesParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read external Besu nodes, got error: %s", err))
 		return
 	}
 
-	var nodes []map[string]interface{}
-	if err := json.Unmarshal(body, &nodes); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu nodes: %s", err))
-		return
-	}
-
-	data.Nodes = make([]ExternalBesuNodeModel, 0, len(nodes))
-	for _, node := range nodes {
-		nodeModel := ExternalBesuNodeModel{}
-
-		if id, ok := node["id"].(float64); ok {
-			nodeModel.ID = types.Int64Value(int64(id))
-		}
-
-		if externalNodeID, ok := node["externalNodeId"].(float64); ok {
-			nodeModel.ExternalNodeID = types.Int64Value(int64(externalNodeID))
-		}
-
-		if name, ok := node["name"].(string); ok {
-			nodeModel.Name = types.StringValue(name)
-		}
-
-		if enodeURL, ok := node["enodeUrl"].(string); ok {
-			nodeModel.EnodeURL = types.StringValue(enodeURL)
+	data.Nodes = make([]ExternalBesuNodeModel, 0, len(list.Payload))
+	for _, node := range list.Payload {
+		if node == nil {
+			continue
 		}
-
-		if p2pHost, ok := node["p2pHost"].(string); ok {
-			nodeModel.P2PHost = types.StringValue(p2pHost)
-		}
-
-		if p2pPort, ok := node["p2pPort"].(float64); ok {
-			nodeModel.P2PPort = types.Int64Value(int64(p2pPort))
-		}
-
-		if version, ok := node["version"].(string); ok {
-			nodeModel.Version = types.StringValue(version)
-		}
-
-		if metricsEnabled, ok := node["metricsEnabled"].(bool); ok {
-			nodeModel.MetricsEnabled = types.BoolValue(metricsEnabled)
-		}
-
-		if metricsPort, ok := node["metricsPort"].(float64); ok {
-			nodeModel.MetricsPort = types.Int64Value(int64(metricsPort))
-		}
-
-		data.Nodes = append(data.Nodes, nodeModel)
+		data.Nodes = append(data.Nodes, ExternalBesuNodeModel{
+			ID:             types.Int64Value(node.ID),
+			ExternalNodeID: types.Int64Value(node.ExternalNodeID),
+			Name:           types.StringValue(node.Name),
+			EnodeURL:       types.StringValue(node.EnodeURL),
+			P2PHost:        types.StringValue(node.P2pHost),
+			P2PPort:        types.Int64Value(node.P2pPort),
+			Version:        types.StringValue(node.Version),
+			MetricsEnabled: types.BoolValue(node.MetricsEnabled),
+			MetricsPort:    types.Int64Value(node.MetricsPort),
+		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
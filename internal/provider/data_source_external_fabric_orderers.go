@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/external_nodes"
 )
 
 var _ datasource.DataSource = &ExternalFabricOrderersDataSource{}
@@ -118,55 +119,27 @@ func (d *ExternalFabricOrderersDataSource) Configure(ctx context.Context, req da
 func (d *ExternalFabricOrderersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ExternalFabricOrderersDataSourceModel
 
-	body, err := d.client.DoRequest("GET", "/external-nodes/fabric-orderers", nil)
+	list, err := d.client.API.ExternalNodes.ListExternalFabricOrderers(external_nodes.NewListExternalFabricOrderersParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read external Fabric orderers, got error: %s", err))
 		return
 	}
 
-	var orderers []map[string]interface{}
-	if err := json.Unmarshal(body, &orderers); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse orderers: %s", err))
-		return
-	}
-
-	data.Orderers = make([]ExternalFabricOrdererModel, 0, len(orderers))
-	for _, orderer := range orderers {
-		ordererModel := ExternalFabricOrdererModel{}
-
-		if id, ok := orderer["id"].(float64); ok {
-			ordererModel.ID = types.Int64Value(int64(id))
-		}
-
-		if externalNodeID, ok := orderer["externalNodeId"].(float64); ok {
-			ordererModel.ExternalNodeID = types.Int64Value(int64(externalNodeID))
-		}
-
-		if name, ok := orderer["name"].(string); ok {
-			ordererModel.Name = types.StringValue(name)
-		}
-
-		if mspID, ok := orderer["mspId"].(string); ok {
-			ordererModel.MSPID = types.StringValue(mspID)
-		}
-
-		if endpoint, ok := orderer["externalEndpoint"].(string); ok {
-			ordererModel.ExternalEndpoint = types.StringValue(endpoint)
-		}
-
-		if version, ok := orderer["version"].(string); ok {
-			ordererModel.Version = types.StringValue(version)
+	data.Orderers = make([]ExternalFabricOrdererModel, 0, len(list.Payload))
+	for _, orderer := range list.Payload {
+		if orderer == nil {
+			continue
 		}
-
-		if signCert, ok := orderer["signCertificate"].(string); ok {
-			ordererModel.SignCertificate = types.StringValue(signCert)
-		}
-
-		if tlsCert, ok := orderer["tlsCertificate"].(string); ok {
-			ordererModel.TLSCertificate = types.StringValue(tlsCert)
-		}
-
-		data.Orderers = append(data.Orderers, ordererModel)
+		data.Orderers = append(data.Orderers, ExternalFabricOrdererModel{
+			ID:               types.Int64Value(orderer.ID),
+			ExternalNodeID:   types.Int64Value(orderer.ExternalNodeID),
+			Name:             types.StringValue(orderer.Name),
+			MSPID:            types.StringValue(orderer.MspID),
+			ExternalEndpoint: types.StringValue(orderer.ExternalEndpoint),
+			Version:          types.StringValue(orderer.Version),
+			SignCertificate:  types.StringValue(orderer.SignCertificate),
+			TLSCertificate:   types.StringValue(orderer.TLSCertificate),
+		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/external_nodes"
 )
 
 var _ datasource.DataSource = &ExternalFabricOrganizationsDataSource{}
@@ -94,39 +95,23 @@ func (d *ExternalFabricOrganizationsDataSource) Configure(ctx context.Context, r
 func (d *ExternalFabricOrganizationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ExternalFabricOrganizationsDataSourceModel
 
-	body, err := d.client.DoRequest("GET", "/external-nodes/fabric-organizations", nil)
+	list, err := d.client.API.ExternalNodes.ListExternalFabricOrganizations(external_nodes.NewListExternalFabricOrganizationsParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read external Fabric organizations, got error: %s", err))
 		return
 	}
 
-	var orgs []map[string]interface{}
-	if err := json.Unmarshal(body, &orgs); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse organizations: %s", err))
-		return
-	}
-
-	data.Organizations = make([]ExternalFabricOrganizationModel, 0, len(orgs))
-	for _, org := range orgs {
-		orgModel := ExternalFabricOrganizationModel{}
-
-		if id, ok := org["id"].(float64); ok {
-			orgModel.ID = types.Int64Value(int64(id))
-		}
-
-		if mspID, ok := org["mspId"].(string); ok {
-			orgModel.MSPID = types.StringValue(mspID)
-		}
-
-		if signCert, ok := org["signCertificate"].(string); ok {
-			orgModel.SignCertificate = types.StringValue(signCert)
+	data.Organizations = make([]ExternalFabricOrganizationModel, 0, len(list.Payload))
+	for _, org := range list.Payload {
+		if org == nil {
+			continue
 		}
-
-		if tlsCert, ok := org["tlsCertificate"].(string); ok {
-			orgModel.TLSCertificate = types.StringValue(tlsCert)
-		}
-
-		data.Organizations = append(data.Organizations, orgModel)
+		data.Organizations = append(data.Organizations, ExternalFabricOrganizationModel{
+			ID:              types.Int64Value(org.ID),
+			MSPID:           types.StringValue(org.MspID),
+			SignCertificate: types.StringValue(org.SignCertificate),
+			TLSCertificate:  types.StringValue(org.TLSCertificate),
+		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/external_nodes"
 )
 
 var _ datasource.DataSource = &ExternalFabricPeersDataSource{}
@@ -108,55 +109,27 @@ func (d *ExternalFabricPeersDataSource) Configure(ctx context.Context, req datas
 func (d *ExternalFabricPeersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data ExternalFabricPeersDataSourceModel
 
-	body, err := d.client.DoRequest("GET", "/external-nodes/fabric-peers", nil)
+	list, err := d.client.API.ExternalNodes.ListExternalFabricPeers(external_nodes.NewListExternalFabricPeersParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read external Fabric peers, got error: %s", err))
 		return
 	}
 
-	var peers []map[string]interface{}
-	if err := json.Unmarshal(body, &peers); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peers: %s", err))
-		return
-	}
-
-	data.Peers = make([]ExternalFabricPeerModel, 0, len(peers))
-	for _, peer := range peers {
-		peerModel := ExternalFabricPeerModel{}
-
-		if id, ok := peer["id"].(float64); ok {
-			peerModel.ID = types.Int64Value(int64(id))
-		}
-
-		if externalNodeID, ok := peer["externalNodeId"].(float64); ok {
-			peerModel.ExternalNodeID = types.Int64Value(int64(externalNodeID))
-		}
-
-		if name, ok := peer["name"].(string); ok {
-			peerModel.Name = types.StringValue(name)
-		}
-
-		if mspID, ok := peer["mspId"].(string); ok {
-			peerModel.MSPID = types.StringValue(mspID)
-		}
-
-		if endpoint, ok := peer["externalEndpoint"].(string); ok {
-			peerModel.ExternalEndpoint = types.StringValue(endpoint)
-		}
-
-		if version, ok := peer["version"].(string); ok {
-			peerModel.Version = types.StringValue(version)
+	data.Peers = make([]ExternalFabricPeerModel, 0, len(list.Payload))
+	for _, peer := range list.Payload {
+		if peer == nil {
+			continue
 		}
-
-		if signCert, ok := peer["signCertificate"].(string); ok {
-			peerModel.SignCertificate = types.StringValue(signCert)
-		}
-
-		if tlsCert, ok := peer["tlsCertificate"].(string); ok {
-			peerModel.TLSCertificate = types.StringValue(tlsCert)
-		}
-
-		data.Peers = append(data.Peers, peerModel)
+		data.Peers = append(data.Peers, ExternalFabricPeerModel{
+			ID:               types.Int64Value(peer.ID),
+			ExternalNodeID:   types.Int64Value(peer.ExternalNodeID),
+			Name:             types.StringValue(peer.Name),
+			MSPID:            types.StringValue(peer.MspID),
+			ExternalEndpoint: types.StringValue(peer.ExternalEndpoint),
+			Version:          types.StringValue(peer.Version),
+			SignCertificate:  types.StringValue(peer.SignCertificate),
+			TLSCertificate:   types.StringValue(peer.TLSCertificate),
+		})
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
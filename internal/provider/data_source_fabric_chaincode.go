@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/smart_contracts"
 )
 
 var _ datasource.DataSource = &FabricChaincodeDataSource{}
@@ -92,31 +93,16 @@ func (d *FabricChaincodeDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// List all chaincodes and find the one matching name and network_id
-	body, err := d.client.DoRequest("GET", "/sc/fabric/chaincodes", nil)
+	list, err := d.client.API.SmartContracts.ListFabricChaincodes(smart_contracts.NewListFabricChaincodesParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list chaincodes: %s", err))
 		return
 	}
 
-	var listResp struct {
-		Chaincodes []struct {
-			ID              int64  `json:"id"`
-			Name            string `json:"name"`
-			NetworkID       int64  `json:"network_id"`
-			NetworkName     string `json:"network_name"`
-			NetworkPlatform string `json:"network_platform"`
-			CreatedAt       string `json:"created_at"`
-		} `json:"chaincodes"`
-	}
-	if err := json.Unmarshal(body, &listResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return
-	}
-
 	// Find matching chaincode
 	var found bool
-	for _, cc := range listResp.Chaincodes {
-		if cc.Name == data.Name.ValueString() && cc.NetworkID == data.NetworkID.ValueInt64() {
+	for _, cc := range list.Payload.Chaincodes {
+		if cc != nil && cc.Name == data.Name.ValueString() && cc.NetworkID == data.NetworkID.ValueInt64() {
 			data.ID = types.Int64Value(cc.ID)
 			data.Name = types.StringValue(cc.Name)
 			data.NetworkID = types.Int64Value(cc.NetworkID)
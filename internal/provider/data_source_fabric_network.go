@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
 )
 
 var _ datasource.DataSource = &FabricNetworkDataSource{}
@@ -91,50 +92,26 @@ func (d *FabricNetworkDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	// List all Fabric networks and find the one matching the name
-	body, err := d.client.DoRequest("GET", "/networks/fabric", nil)
+	got, err := d.client.API.FabricNetworks.GetFabricNetworkByName(fabric_networks.NewGetFabricNetworkByNameParamsWithContext(ctx).WithName(data.Name.ValueString()), nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Fabric networks: %s", err))
-		return
-	}
-
-	var listResp struct {
-		Networks []struct {
-			ID          int64  `json:"id"`
-			Name        string `json:"name"`
-			Platform    string `json:"platform"`
-			Description string `json:"description"`
-			Status      string `json:"status"`
-			CreatedAt   string `json:"createdAt"`
-		} `json:"networks"`
-	}
-	if err := json.Unmarshal(body, &listResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return
-	}
-
-	// Find matching network
-	var found bool
-	for _, network := range listResp.Networks {
-		if network.Name == data.Name.ValueString() {
-			data.ID = types.Int64Value(network.ID)
-			data.Name = types.StringValue(network.Name)
-			data.Platform = types.StringValue(network.Platform)
-			data.Description = types.StringValue(network.Description)
-			data.Status = types.StringValue(network.Status)
-			data.CreatedAt = types.StringValue(network.CreatedAt)
-			found = true
-			break
+		if IsNotFoundError(err) {
+			resp.Diagnostics.AddError(
+				"Fabric Network Not Found",
+				fmt.Sprintf("No Fabric network found with name '%s'", data.Name.ValueString()),
+			)
+			return
 		}
-	}
-
-	if !found {
-		resp.Diagnostics.AddError(
-			"Fabric Network Not Found",
-			fmt.Sprintf("No Fabric network found with name '%s'", data.Name.ValueString()),
-		)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fabric network: %s", err))
 		return
 	}
+	network := got.Payload
+
+	data.ID = types.Int64Value(network.ID)
+	data.Name = types.StringValue(network.Name)
+	data.Platform = types.StringValue(network.Platform)
+	data.Description = types.StringValue(network.Description)
+	data.Status = types.StringValue(network.Status)
+	data.CreatedAt = types.StringValue(network.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -141,77 +143,40 @@ func (d *FabricOrdererDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	// Use NodeResponse which has the fabricOrderer nested object
-	var nodeResp struct {
-		ID            int64                  `json:"id"`
-		Name          string                 `json:"name"`
-		Platform      string                 `json:"blockchainPlatform,omitempty"`
-		Type          string                 `json:"type,omitempty"`
-		Status        string                 `json:"status,omitempty"`
-		CreatedAt     string                 `json:"createdAt,omitempty"`
-		UpdatedAt     string                 `json:"updatedAt,omitempty"`
-		Config        map[string]interface{} `json:"config,omitempty"`
-		FabricOrderer map[string]interface{} `json:"fabricOrderer,omitempty"`
-	}
+	var nodeResp *models.HTTPNodeResponse
 
 	if hasID {
 		// Lookup by ID
-		body, err := d.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+		id, err := parseID(data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fabric orderer, got error: %s", err))
+			resp.Diagnostics.AddError("Invalid ID", err.Error())
 			return
 		}
 
-		if err := json.Unmarshal(body, &nodeResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse orderer response, got error: %s", err))
+		got, err := d.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fabric orderer, got error: %s", err))
 			return
 		}
+		nodeResp = got.Payload
 	} else {
-		// Lookup by name using query parameter (API-side filtering)
-		body, err := d.client.DoRequest("GET", fmt.Sprintf("/nodes?name=%s", data.Name.ValueString()), nil)
+		// Lookup by name
+		node, err := d.client.findNode(ctx, models.TypesBlockchainPlatformFABRIC, func(node *models.HTTPNodeResponse) bool {
+			return node.Name == data.Name.ValueString() && node.FabricOrderer != nil
+		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search for fabric orderer by name, got error: %s", err))
 			return
 		}
 
-		// Parse paginated response
-		var paginatedResp struct {
-			Items []struct {
-				ID            int64                  `json:"id"`
-				Name          string                 `json:"name"`
-				Platform      string                 `json:"blockchainPlatform,omitempty"`
-				Type          string                 `json:"type,omitempty"`
-				Status        string                 `json:"status,omitempty"`
-				CreatedAt     string                 `json:"createdAt,omitempty"`
-				UpdatedAt     string                 `json:"updatedAt,omitempty"`
-				Config        map[string]interface{} `json:"config,omitempty"`
-				FabricOrderer map[string]interface{} `json:"fabricOrderer,omitempty"`
-			} `json:"items"`
-			Total int `json:"total"`
-		}
-
-		if err := json.Unmarshal(body, &paginatedResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse paginated nodes response, got error: %s", err))
-			return
-		}
-
-		// Find the orderer with matching name
-		found := false
-		for _, node := range paginatedResp.Items {
-			if node.Name == data.Name.ValueString() && node.FabricOrderer != nil {
-				nodeResp = node
-				found = true
-				break
-			}
-		}
-
-		if !found {
+		if node == nil {
 			resp.Diagnostics.AddError(
 				"Orderer Not Found",
 				fmt.Sprintf("No fabric orderer found with name: %s", data.Name.ValueString()),
 			)
 			return
 		}
+		nodeResp = node
 	}
 
 	// Set ID and Name
@@ -219,27 +184,27 @@ func (d *FabricOrdererDataSource) Read(ctx context.Context, req datasource.ReadR
 	data.Name = types.StringValue(nodeResp.Name)
 
 	// Extract data from fabricOrderer nested object if present
-	if nodeResp.FabricOrderer != nil {
-		if orgID, ok := nodeResp.FabricOrderer["organizationId"].(float64); ok {
-			data.OrganizationID = types.Int64Value(int64(orgID))
+	if props := nodeResp.FabricOrderer; props != nil {
+		if props.OrganizationID > 0 {
+			data.OrganizationID = types.Int64Value(props.OrganizationID)
 		}
-		if mode, ok := nodeResp.FabricOrderer["mode"].(string); ok {
-			data.Mode = types.StringValue(mode)
+		if props.Mode != "" {
+			data.Mode = types.StringValue(props.Mode)
 		}
-		if version, ok := nodeResp.FabricOrderer["version"].(string); ok {
-			data.Version = types.StringValue(version)
+		if props.Version != "" {
+			data.Version = types.StringValue(props.Version)
 		}
-		if externalEndpoint, ok := nodeResp.FabricOrderer["externalEndpoint"].(string); ok {
-			data.ExternalEndpoint = types.StringValue(externalEndpoint)
+		if props.ExternalEndpoint != "" {
+			data.ExternalEndpoint = types.StringValue(props.ExternalEndpoint)
 		}
-		if listenAddr, ok := nodeResp.FabricOrderer["listenAddress"].(string); ok {
-			data.ListenAddress = types.StringValue(listenAddr)
+		if props.ListenAddress != "" {
+			data.ListenAddress = types.StringValue(props.ListenAddress)
 		}
-		if adminAddr, ok := nodeResp.FabricOrderer["adminAddress"].(string); ok {
-			data.AdminAddress = types.StringValue(adminAddr)
+		if props.AdminAddress != "" {
+			data.AdminAddress = types.StringValue(props.AdminAddress)
 		}
-		if opsAddr, ok := nodeResp.FabricOrderer["operationsListenAddress"].(string); ok {
-			data.OperationsListenAddress = types.StringValue(opsAddr)
+		if props.OperationsAddress != "" {
+			data.OperationsListenAddress = types.StringValue(props.OperationsAddress)
 		}
 	}
 
@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -106,61 +108,42 @@ func (d *OrganizationDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	var org Organization
+	var org *models.HandlerOrganizationResponse
 
 	if hasID {
-		// Lookup by ID - direct GET
-		body, err := d.client.DoRequest("GET", fmt.Sprintf("/organizations/%s", data.ID.ValueString()), nil)
+		// Lookup by ID
+		id, err := parseID(data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+			resp.Diagnostics.AddError("Invalid ID", err.Error())
 			return
 		}
 
-		if err := json.Unmarshal(body, &org); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse organization response, got error: %s", err))
+		got, err := d.client.API.Organizations.GetFabricOrganization(organizations.NewGetFabricOrganizationParamsWithContext(ctx).WithID(id), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
 			return
 		}
+		org = got.Payload
 	} else {
-		// Lookup by MSP ID using query parameter (API-side filtering)
-		body, err := d.client.DoRequest("GET", fmt.Sprintf("/organizations?mspId=%s", data.MSPID.ValueString()), nil)
+		// Lookup by MSP ID
+		got, err := d.client.API.Organizations.GetFabricOrganizationByMspID(organizations.NewGetFabricOrganizationByMspIDParamsWithContext(ctx).WithMspid(data.MSPID.ValueString()), nil)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search for organization by msp_id, got error: %s", err))
-			return
-		}
-
-		// Parse paginated response
-		var paginatedResp struct {
-			Items []Organization `json:"items"`
-			Count int            `json:"count"`
-		}
-
-		if err := json.Unmarshal(body, &paginatedResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse paginated organizations response, got error: %s", err))
-			return
-		}
-
-		// Find the organization with matching MSP ID
-		found := false
-		for _, item := range paginatedResp.Items {
-			if item.MSPID == data.MSPID.ValueString() {
-				org = item
-				found = true
-				break
+			if IsNotFoundError(err) {
+				resp.Diagnostics.AddError(
+					"Organization Not Found",
+					fmt.Sprintf("No organization found with msp_id: %s", data.MSPID.ValueString()),
+				)
+				return
 			}
-		}
-
-		if !found {
-			resp.Diagnostics.AddError(
-				"Organization Not Found",
-				fmt.Sprintf("No organization found with msp_id: %s", data.MSPID.ValueString()),
-			)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search for organization by msp_id, got error: %s", err))
 			return
 		}
+		org = got.Payload
 	}
 
 	// Set all fields from the organization
 	data.ID = types.StringValue(fmt.Sprintf("%d", org.ID))
-	data.MSPID = types.StringValue(org.MSPID)
+	data.MSPID = types.StringValue(org.MspID)
 	if org.Description != "" {
 		data.Description = types.StringValue(org.Description)
 	}
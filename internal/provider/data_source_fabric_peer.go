@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -146,77 +148,40 @@ func (d *FabricPeerDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	// Use NodeResponse which has the fabricPeer nested object
-	var nodeResp struct {
-		ID         int64                  `json:"id"`
-		Name       string                 `json:"name"`
-		Platform   string                 `json:"blockchainPlatform,omitempty"`
-		Type       string                 `json:"type,omitempty"`
-		Status     string                 `json:"status,omitempty"`
-		CreatedAt  string                 `json:"createdAt,omitempty"`
-		UpdatedAt  string                 `json:"updatedAt,omitempty"`
-		Config     map[string]interface{} `json:"config,omitempty"`
-		FabricPeer map[string]interface{} `json:"fabricPeer,omitempty"`
-	}
+	var nodeResp *models.HTTPNodeResponse
 
 	if hasID {
 		// Lookup by ID
-		body, err := d.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+		id, err := parseID(data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fabric peer, got error: %s", err))
+			resp.Diagnostics.AddError("Invalid ID", err.Error())
 			return
 		}
 
-		if err := json.Unmarshal(body, &nodeResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peer response, got error: %s", err))
+		got, err := d.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fabric peer, got error: %s", err))
 			return
 		}
+		nodeResp = got.Payload
 	} else {
-		// Lookup by name using query parameter (API-side filtering)
-		body, err := d.client.DoRequest("GET", fmt.Sprintf("/nodes?name=%s", data.Name.ValueString()), nil)
+		// Lookup by name
+		node, err := d.client.findNode(ctx, models.TypesBlockchainPlatformFABRIC, func(node *models.HTTPNodeResponse) bool {
+			return node.Name == data.Name.ValueString() && node.FabricPeer != nil
+		})
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search for fabric peer by name, got error: %s", err))
 			return
 		}
 
-		// Parse paginated response
-		var paginatedResp struct {
-			Items []struct {
-				ID         int64                  `json:"id"`
-				Name       string                 `json:"name"`
-				Platform   string                 `json:"blockchainPlatform,omitempty"`
-				Type       string                 `json:"type,omitempty"`
-				Status     string                 `json:"status,omitempty"`
-				CreatedAt  string                 `json:"createdAt,omitempty"`
-				UpdatedAt  string                 `json:"updatedAt,omitempty"`
-				Config     map[string]interface{} `json:"config,omitempty"`
-				FabricPeer map[string]interface{} `json:"fabricPeer,omitempty"`
-			} `json:"items"`
-			Total int `json:"total"`
-		}
-
-		if err := json.Unmarshal(body, &paginatedResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse paginated nodes response, got error: %s", err))
-			return
-		}
-
-		// Find the peer with matching name
-		found := false
-		for _, node := range paginatedResp.Items {
-			if node.Name == data.Name.ValueString() && node.FabricPeer != nil {
-				nodeResp = node
-				found = true
-				break
-			}
-		}
-
-		if !found {
+		if node == nil {
 			resp.Diagnostics.AddError(
 				"Peer Not Found",
 				fmt.Sprintf("No fabric peer found with name: %s", data.Name.ValueString()),
 			)
 			return
 		}
+		nodeResp = node
 	}
 
 	// Set ID and Name
@@ -224,30 +189,30 @@ func (d *FabricPeerDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	data.Name = types.StringValue(nodeResp.Name)
 
 	// Extract data from fabricPeer nested object if present
-	if nodeResp.FabricPeer != nil {
-		if orgID, ok := nodeResp.FabricPeer["organizationId"].(float64); ok {
-			data.OrganizationID = types.Int64Value(int64(orgID))
+	if props := nodeResp.FabricPeer; props != nil {
+		if props.OrganizationID > 0 {
+			data.OrganizationID = types.Int64Value(props.OrganizationID)
 		}
-		if mode, ok := nodeResp.FabricPeer["mode"].(string); ok {
-			data.Mode = types.StringValue(mode)
+		if props.Mode != "" {
+			data.Mode = types.StringValue(props.Mode)
 		}
-		if version, ok := nodeResp.FabricPeer["version"].(string); ok {
-			data.Version = types.StringValue(version)
+		if props.Version != "" {
+			data.Version = types.StringValue(props.Version)
 		}
-		if externalEndpoint, ok := nodeResp.FabricPeer["externalEndpoint"].(string); ok {
-			data.ExternalEndpoint = types.StringValue(externalEndpoint)
+		if props.ExternalEndpoint != "" {
+			data.ExternalEndpoint = types.StringValue(props.ExternalEndpoint)
 		}
-		if listenAddr, ok := nodeResp.FabricPeer["listenAddress"].(string); ok {
-			data.ListenAddress = types.StringValue(listenAddr)
+		if props.ListenAddress != "" {
+			data.ListenAddress = types.StringValue(props.ListenAddress)
 		}
-		if chaincodeAddr, ok := nodeResp.FabricPeer["chaincodeAddress"].(string); ok {
-			data.ChaincodeAddress = types.StringValue(chaincodeAddr)
+		if props.ChaincodeAddress != "" {
+			data.ChaincodeAddress = types.StringValue(props.ChaincodeAddress)
 		}
-		if eventsAddr, ok := nodeResp.FabricPeer["eventsAddress"].(string); ok {
-			data.EventsAddress = types.StringValue(eventsAddr)
+		if props.EventsAddress != "" {
+			data.EventsAddress = types.StringValue(props.EventsAddress)
 		}
-		if opsAddr, ok := nodeResp.FabricPeer["operationsListenAddress"].(string); ok {
-			data.OperationsListenAddress = types.StringValue(opsAddr)
+		if props.OperationsAddress != "" {
+			data.OperationsListenAddress = types.StringValue(props.OperationsAddress)
 		}
 	}
 
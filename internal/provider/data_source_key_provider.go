@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/providers"
 )
 
 var _ datasource.DataSource = &KeyProviderDataSource{}
@@ -97,35 +99,28 @@ func (d *KeyProviderDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	body, err := d.client.DoRequest("GET", fmt.Sprintf("/key-providers/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key provider, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var keyProvider KeyProvider
-	if err := json.Unmarshal(body, &keyProvider); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse key provider response, got error: %s", err))
+	got, err := d.client.API.Providers.GetKeyProvider(providers.NewGetKeyProviderParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key provider, got error: %s", err))
 		return
 	}
+	keyProvider := got.Payload
 
 	data.Name = types.StringValue(keyProvider.Name)
-	data.Type = types.StringValue(keyProvider.Type)
-	if keyProvider.Status != "" {
-		data.Status = types.StringValue(keyProvider.Status)
-	}
-	if keyProvider.Config != nil {
-		configJSON, err := json.Marshal(keyProvider.Config)
-		if err == nil {
-			data.Config = types.StringValue(string(configJSON))
-		}
+	data.Type = types.StringValue(string(keyProvider.Type))
+	configJSON, err := json.Marshal(keyProvider.Config)
+	if err == nil {
+		data.Config = types.StringValue(string(configJSON))
 	}
 	if keyProvider.CreatedAt != "" {
 		data.CreatedAt = types.StringValue(keyProvider.CreatedAt)
 	}
-	if keyProvider.UpdatedAt != "" {
-		data.UpdatedAt = types.StringValue(keyProvider.UpdatedAt)
-	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/providers"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -128,48 +130,43 @@ func (d *KeyProvidersDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	// Fetch all key providers
-	body, err := d.client.DoRequest("GET", "/key-providers", nil)
+	list, err := d.client.API.Providers.ListKeyProviders(providers.NewListKeyProvidersParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key providers, got error: %s", err))
 		return
 	}
 
-	var keyProviders []KeyProvider
-	if err := json.Unmarshal(body, &keyProviders); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse key providers response, got error: %s", err))
-		return
-	}
-
 	// Filter and process providers
 	var filteredProviders []KeyProviderItem
-	var defaultProvider *KeyProvider
+	var defaultProvider *models.ModelsProviderResponse
 
 	nameFilter := data.NameFilter.ValueString()
 	typeFilter := data.TypeFilter.ValueString()
 
-	for _, provider := range keyProviders {
+	for _, provider := range list.Payload {
+		if provider == nil {
+			continue
+		}
+
 		// Check if this is the default provider (case-insensitive)
-		providerTypeLower := toLower(provider.Type)
-		if providerTypeLower == "database" || provider.Name == "Default Database Provider" {
+		providerTypeLower := toLower(string(provider.Type))
+		if provider.IsDefault == 1 || providerTypeLower == "database" || provider.Name == "Default Database Provider" {
 			if defaultProvider == nil {
-				defaultProvider = &provider
+				defaultProvider = provider
 			}
 		}
 
 		// Apply filters (case-insensitive for type)
 		matchesName := nameFilter == "" || containsIgnoreCase(provider.Name, nameFilter)
-		matchesType := typeFilter == "" || toLower(provider.Type) == toLower(typeFilter)
+		matchesType := typeFilter == "" || providerTypeLower == toLower(typeFilter)
 
 		if matchesName && matchesType {
 			item := KeyProviderItem{
 				ID:   types.StringValue(fmt.Sprintf("%d", provider.ID)),
 				Name: types.StringValue(provider.Name),
-				Type: types.StringValue(provider.Type),
-			}
-			if provider.Status != "" {
-				item.Status = types.StringValue(provider.Status)
-			} else {
-				item.Status = types.StringValue("unknown")
+				Type: types.StringValue(string(provider.Type)),
+				// The API does not report a status for key providers
+				Status: types.StringValue("unknown"),
 			}
 			filteredProviders = append(filteredProviders, item)
 		}
@@ -182,7 +179,7 @@ func (d *KeyProvidersDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if defaultProvider != nil {
 		data.DefaultProviderID = types.Int64Value(defaultProvider.ID)
 		data.DefaultProviderName = types.StringValue(defaultProvider.Name)
-		data.DefaultProviderType = types.StringValue(defaultProvider.Type)
+		data.DefaultProviderType = types.StringValue(string(defaultProvider.Type))
 	} else {
 		data.DefaultProviderID = types.Int64Null()
 		data.DefaultProviderName = types.StringNull()
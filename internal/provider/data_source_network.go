@@ -96,22 +96,19 @@ func (d *NetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/%s/%s", data.Type.ValueString(), data.ID.ValueString())
-
-	body, err := d.client.DoRequest("GET", endpoint, nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var network Network
-	if err := json.Unmarshal(body, &network); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response, got error: %s", err))
+	network, err := d.client.getNetwork(ctx, data.Type.ValueString(), id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network, got error: %s", err))
 		return
 	}
 
 	data.Name = types.StringValue(network.Name)
-	data.Type = types.StringValue(network.Type)
 	if network.Status != "" {
 		data.Status = types.StringValue(network.Status)
 	}
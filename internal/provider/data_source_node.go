@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
 )
 
 var _ datasource.DataSource = &NodeDataSource{}
@@ -101,26 +103,38 @@ func (d *NodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	body, err := d.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var node Node
-	if err := json.Unmarshal(body, &node); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse node response, got error: %s", err))
+	got, err := d.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
 		return
 	}
+	node := got.Payload
 
 	data.Name = types.StringValue(node.Name)
 	data.Platform = types.StringValue(node.Platform)
-	data.Type = types.StringValue(node.Type)
+	data.Type = types.StringValue(node.NodeType)
 	if node.Status != "" {
 		data.Status = types.StringValue(node.Status)
 	}
-	if node.Config != nil {
-		configJSON, err := json.Marshal(node.Config)
+
+	// config is the platform specific configuration of the node
+	var config interface{}
+	switch {
+	case node.FabricPeer != nil:
+		config = node.FabricPeer
+	case node.FabricOrderer != nil:
+		config = node.FabricOrderer
+	case node.BesuNode != nil:
+		config = node.BesuNode
+	}
+	if config != nil {
+		configJSON, err := json.Marshal(config)
 		if err == nil {
 			data.Config = types.StringValue(string(configJSON))
 		}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionsDataSource{}
+
+func NewPermissionsDataSource() datasource.DataSource {
+	return &PermissionsDataSource{}
+}
+
+// PermissionsDataSource defines the data source implementation.
+type PermissionsDataSource struct {
+	client *Client
+}
+
+// PermissionItem represents a single permission in the list
+type PermissionItem struct {
+	Code        types.String `tfsdk:"code"`
+	Name        types.String `tfsdk:"name"`
+	Category    types.String `tfsdk:"category"`
+	Description types.String `tfsdk:"description"`
+}
+
+// RoleItem represents a role and the codes of the permissions it grants
+type RoleItem struct {
+	Role        types.String `tfsdk:"role"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions []string     `tfsdk:"permissions"`
+}
+
+// PermissionsDataSourceModel describes the data source data model.
+type PermissionsDataSourceModel struct {
+	ID          types.String     `tfsdk:"id"`
+	Permissions []PermissionItem `tfsdk:"permissions"`
+	Roles       []RoleItem       `tfsdk:"roles"`
+}
+
+func (d *PermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (d *PermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the permissions known to Chainlaunch and the roles that grant them.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Placeholder identifier for the data source.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of all permissions.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Computed:    true,
+							Description: "The permission code (e.g., NETWORK_CREATE).",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the permission.",
+						},
+						"category": schema.StringAttribute{
+							Computed:    true,
+							Description: "The category of the permission (e.g., Networks).",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "What the permission allows.",
+						},
+					},
+				},
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of roles with the permissions they grant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The role, as used by the role attribute of chainlaunch_user (e.g., ADMIN).",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The display name of the role.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "What the role is for.",
+						},
+						"permissions": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Codes of the permissions granted by the role.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := getPermissions(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions, got error: %s", err))
+		return
+	}
+
+	data.Permissions = []PermissionItem{}
+	for _, permission := range permissions.Permissions {
+		if permission == nil {
+			continue
+		}
+		data.Permissions = append(data.Permissions, PermissionItem{
+			Code:        types.StringValue(permission.Code),
+			Name:        types.StringValue(permission.Name),
+			Category:    stringValueOrNull(permission.Category),
+			Description: stringValueOrNull(permission.Description),
+		})
+	}
+
+	data.Roles = []RoleItem{}
+	for _, role := range permissions.Roles {
+		data.Roles = append(data.Roles, RoleItem{
+			Role:        types.StringValue(string(role.Role)),
+			Name:        types.StringValue(role.Name),
+			Description: stringValueOrNull(role.Description),
+			Permissions: permissions.rolePermissionCodes(role.Role),
+		})
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("permissions")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/plugins"
 )
 
 var (
@@ -120,76 +121,38 @@ func (d *PluginDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get plugin from API
-	pluginResp, err := d.client.DoRequest("GET", fmt.Sprintf("/plugins/%s", data.Name.ValueString()), nil)
+	plugin, err := getPlugin(ctx, d.client, data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to read plugin", err.Error())
 		return
 	}
 
-	// Parse response
-	var pluginResult map[string]interface{}
-	if err := json.Unmarshal(pluginResp, &pluginResult); err != nil {
-		resp.Diagnostics.AddError("Failed to parse plugin response", err.Error())
-		return
-	}
-
-	// Extract metadata
-	metadata, ok := pluginResult["metadata"].(map[string]interface{})
-	if !ok {
+	if plugin.Metadata == nil {
 		resp.Diagnostics.AddError("Invalid plugin response", "Missing metadata field")
 		return
 	}
 
 	// Set computed fields
-	if apiVersion, ok := pluginResult["apiVersion"].(string); ok {
-		data.APIVersion = types.StringValue(apiVersion)
-	}
-	if kind, ok := pluginResult["kind"].(string); ok {
-		data.Kind = types.StringValue(kind)
-	}
-	if version, ok := metadata["version"].(string); ok {
-		data.MetadataVersion = types.StringValue(version)
-	}
-	if description, ok := metadata["description"].(string); ok {
-		data.Description = types.StringValue(description)
-	}
-	if author, ok := metadata["author"].(string); ok {
-		data.Author = types.StringValue(author)
-	}
-	if repository, ok := metadata["repository"].(string); ok {
-		data.Repository = types.StringValue(repository)
-	}
-	if license, ok := metadata["license"].(string); ok {
-		data.License = types.StringValue(license)
-	}
-
-	// Extract tags
-	if tags, ok := metadata["tags"].([]interface{}); ok {
-		var tagsList []string
-		for _, tag := range tags {
-			if tagStr, ok := tag.(string); ok {
-				tagsList = append(tagsList, tagStr)
-			}
-		}
-		tagsListValue, diags := types.ListValueFrom(ctx, types.StringType, tagsList)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Tags = tagsListValue
-		}
+	data.APIVersion = types.StringValue(plugin.APIVersion)
+	data.Kind = types.StringValue(plugin.Kind)
+	data.MetadataVersion = types.StringValue(plugin.Metadata.Version)
+	data.Description = types.StringValue(plugin.Metadata.Description)
+	data.Author = types.StringValue(plugin.Metadata.Author)
+	data.Repository = types.StringValue(plugin.Metadata.Repository)
+	data.License = types.StringValue(plugin.Metadata.License)
+
+	tags, diags := types.ListValueFrom(ctx, types.StringType, plugin.Metadata.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	data.Tags = tags
 
 	// Try to get deployment status
-	statusResp, err := d.client.DoRequest("GET", fmt.Sprintf("/plugins/%s/deployment-status", data.Name.ValueString()), nil)
+	status, err := d.client.API.Plugins.GetDeploymentStatus(plugins.NewGetDeploymentStatusParamsWithContext(ctx).WithName(data.Name.ValueString()), nil)
 	if err == nil {
-		var statusResult map[string]interface{}
-		if err := json.Unmarshal(statusResp, &statusResult); err == nil {
-			if status, ok := statusResult["status"].(string); ok {
-				data.Status = types.StringValue(status)
-			}
-			if projectName, ok := statusResult["projectName"].(string); ok {
-				data.ProjectName = types.StringValue(projectName)
-			}
-		}
+		data.Status = types.StringValue(status.Payload.Status)
+		data.ProjectName = types.StringValue(status.Payload.ProjectName)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
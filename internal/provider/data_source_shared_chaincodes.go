@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/pro"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SharedChaincodesDataSource{}
+
+func NewSharedChaincodesDataSource() datasource.DataSource {
+	return &SharedChaincodesDataSource{}
+}
+
+// SharedChaincodesDataSource defines the data source implementation.
+type SharedChaincodesDataSource struct {
+	client *Client
+}
+
+// SharedChaincodeItem represents a single chaincode definition shared with this instance
+type SharedChaincodeItem struct {
+	ID             types.String `tfsdk:"id"`
+	ResourceID     types.String `tfsdk:"resource_id"`
+	Status         types.String `tfsdk:"status"`
+	SharedBy       types.String `tfsdk:"shared_by"`
+	SharedByNodeID types.String `tfsdk:"shared_by_node_id"`
+	ConnectionID   types.Int64  `tfsdk:"connection_id"`
+	DataJSON       types.String `tfsdk:"data_json"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// ChaincodeProposalItem represents a single chaincode proposal
+type ChaincodeProposalItem struct {
+	ID                types.String `tfsdk:"id"`
+	NetworkID         types.Int64  `tfsdk:"network_id"`
+	ChannelName       types.String `tfsdk:"channel_name"`
+	Name              types.String `tfsdk:"name"`
+	Version           types.String `tfsdk:"version"`
+	Sequence          types.Int64  `tfsdk:"sequence"`
+	DockerImage       types.String `tfsdk:"docker_image"`
+	EndorsementPolicy types.String `tfsdk:"endorsement_policy"`
+	Status            types.String `tfsdk:"status"`
+	SharedBy          types.String `tfsdk:"shared_by"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+}
+
+// SharedChaincodesDataSourceModel describes the data source data model.
+type SharedChaincodesDataSourceModel struct {
+	ID         types.String            `tfsdk:"id"`
+	NetworkID  types.Int64             `tfsdk:"network_id"`
+	Chaincodes []SharedChaincodeItem   `tfsdk:"chaincodes"`
+	Proposals  []ChaincodeProposalItem `tfsdk:"proposals"`
+}
+
+func (d *SharedChaincodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_chaincodes"
+}
+
+func (d *SharedChaincodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the chaincode definitions and chaincode proposals other Chainlaunch instances shared with this one (Chainlaunch Pro). " +
+			"Accept a proposal with chainlaunch_chaincode_proposal_acceptance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Placeholder identifier for the data source.",
+			},
+			"network_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Only list the proposals for this Fabric network.",
+			},
+			"chaincodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of chaincode definitions shared with this instance.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the share.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the chaincode on the instance that shared it.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the share.",
+						},
+						"shared_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The instance that shared the chaincode definition.",
+						},
+						"shared_by_node_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The node sharing ID of the instance that shared the chaincode definition.",
+						},
+						"connection_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the node sharing connection the definition was received on.",
+						},
+						"data_json": schema.StringAttribute{
+							Computed:    true,
+							Description: "The shared definition (version, sequence, Docker image and metadata) as JSON.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the definition was shared.",
+						},
+					},
+				},
+			},
+			"proposals": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of chaincode proposals, including the ones received from other instances.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the proposal, used as proposal_id of chainlaunch_chaincode_proposal_acceptance.",
+						},
+						"network_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the Fabric network the chaincode is defined on.",
+						},
+						"channel_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The channel the chaincode is defined on.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the chaincode.",
+						},
+						"version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version of the chaincode definition.",
+						},
+						"sequence": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The sequence of the chaincode definition.",
+						},
+						"docker_image": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Docker image that runs the chaincode.",
+						},
+						"endorsement_policy": schema.StringAttribute{
+							Computed:    true,
+							Description: "The endorsement policy of the chaincode definition.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the proposal.",
+						},
+						"shared_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The instance that shared the proposal, empty for proposals created on this instance.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the proposal was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SharedChaincodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SharedChaincodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SharedChaincodesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shared, err := d.client.API.Pro.ListSharedChaincodes(pro.NewListSharedChaincodesParamsWithContext(ctx), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared chaincodes, got error: %s", err))
+		return
+	}
+
+	data.Chaincodes = []SharedChaincodeItem{}
+	for _, share := range shared.Payload.Chaincodes {
+		if share == nil {
+			continue
+		}
+		item := SharedChaincodeItem{
+			ID:             types.StringValue(share.ID),
+			ResourceID:     stringValueOrNull(share.ResourceID),
+			Status:         stringValueOrNull(share.Status),
+			SharedBy:       stringValueOrNull(share.SharedBy),
+			SharedByNodeID: stringValueOrNull(share.SharedByNodeID),
+			ConnectionID:   types.Int64Value(share.ConnectionID),
+			DataJSON:       types.StringNull(),
+			CreatedAt:      stringValueOrNull(share.CreatedAt),
+		}
+		if share.DataJSON != nil {
+			dataJSON, err := json.Marshal(share.DataJSON)
+			if err != nil {
+				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to encode data of shared chaincode %s, got error: %s", share.ID, err))
+				return
+			}
+			item.DataJSON = types.StringValue(string(dataJSON))
+		}
+		data.Chaincodes = append(data.Chaincodes, item)
+	}
+
+	params := chaincode.NewListChaincodeProposalsParamsWithContext(ctx)
+	if !data.NetworkID.IsNull() {
+		params = params.WithNetworkID(data.NetworkID.ValueInt64Pointer())
+	}
+	proposals, err := d.client.API.Chaincode.ListChaincodeProposals(params, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read chaincode proposals, got error: %s", err))
+		return
+	}
+
+	data.Proposals = []ChaincodeProposalItem{}
+	for _, proposal := range proposals.Payload {
+		if proposal == nil {
+			continue
+		}
+		data.Proposals = append(data.Proposals, ChaincodeProposalItem{
+			ID:                types.StringValue(strconv.FormatInt(proposal.ID, 10)),
+			NetworkID:         types.Int64Value(proposal.NetworkID),
+			ChannelName:       stringValueOrNull(proposal.ChannelName),
+			Name:              stringValueOrNull(proposal.Name),
+			Version:           stringValueOrNull(proposal.Version),
+			Sequence:          types.Int64Value(proposal.Sequence),
+			DockerImage:       stringValueOrNull(proposal.DockerImage),
+			EndorsementPolicy: stringValueOrNull(proposal.EndorsementPolicy),
+			Status:            stringValueOrNull(proposal.Status),
+			SharedBy:          stringValueOrNull(proposal.SharedBy),
+			CreatedAt:         stringValueOrNull(proposal.CreatedAt),
+		})
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("shared_chaincodes")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/pro"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SharedKeysDataSource{}
+
+func NewSharedKeysDataSource() datasource.DataSource {
+	return &SharedKeysDataSource{}
+}
+
+// SharedKeysDataSource defines the data source implementation.
+type SharedKeysDataSource struct {
+	client *Client
+}
+
+// SharedKeyItem represents a single key shared with this instance
+type SharedKeyItem struct {
+	ShareID         types.String `tfsdk:"share_id"`
+	KeyID           types.String `tfsdk:"key_id"`
+	Name            types.String `tfsdk:"name"`
+	Algorithm       types.String `tfsdk:"algorithm"`
+	Curve           types.String `tfsdk:"curve"`
+	KeySize         types.Int64  `tfsdk:"key_size"`
+	Format          types.String `tfsdk:"format"`
+	PublicKey       types.String `tfsdk:"public_key"`
+	EthereumAddress types.String `tfsdk:"ethereum_address"`
+	Metadata        types.Map    `tfsdk:"metadata"`
+	Status          types.String `tfsdk:"status"`
+	SharedBy        types.Int64  `tfsdk:"shared_by"`
+	SharedAt        types.String `tfsdk:"shared_at"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+}
+
+// SharedKeysDataSourceModel describes the data source data model.
+type SharedKeysDataSourceModel struct {
+	ID             types.String    `tfsdk:"id"`
+	Status         types.String    `tfsdk:"status"`
+	IncludeExpired types.Bool      `tfsdk:"include_expired"`
+	Keys           []SharedKeyItem `tfsdk:"keys"`
+}
+
+func (d *SharedKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_keys"
+}
+
+func (d *SharedKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the public keys other Chainlaunch instances shared with this one with chainlaunch_key_share (Chainlaunch Pro).",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Placeholder identifier for the data source.",
+			},
+			"status": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the shares with this status.",
+			},
+			"include_expired": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include expired shares. Defaults to false.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of keys shared with this instance.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"share_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the share.",
+						},
+						"key_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the key on the instance that shared it.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the key.",
+						},
+						"algorithm": schema.StringAttribute{
+							Computed:    true,
+							Description: "The algorithm of the key.",
+						},
+						"curve": schema.StringAttribute{
+							Computed:    true,
+							Description: "The elliptic curve of the key.",
+						},
+						"key_size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size of the key in bits.",
+						},
+						"format": schema.StringAttribute{
+							Computed:    true,
+							Description: "The format of the public key.",
+						},
+						"public_key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The public key.",
+						},
+						"ethereum_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Ethereum address derived from the key, for secp256k1 keys.",
+						},
+						"metadata": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Additional information sent along with the key.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the share.",
+						},
+						"shared_by": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the node sharing connection of the instance that shared the key.",
+						},
+						"shared_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the key was shared.",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp after which the share expires.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SharedKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SharedKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SharedKeysDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := pro.NewGetKeysSharedWithMeParamsWithContext(ctx).WithExpired(data.IncludeExpired.ValueBoolPointer())
+	if !data.Status.IsNull() {
+		params = params.WithStatus(data.Status.ValueStringPointer())
+	}
+
+	data.Keys = []SharedKeyItem{}
+	for offset := int64(0); ; {
+		list, err := d.client.API.Pro.GetKeysSharedWithMe(params.WithOffset(&offset), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared keys, got error: %s", err))
+			return
+		}
+
+		for _, key := range list.Payload.Keys {
+			if key == nil {
+				continue
+			}
+			metadata, diags := types.MapValueFrom(ctx, types.StringType, key.Metadata)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			data.Keys = append(data.Keys, SharedKeyItem{
+				ShareID:         types.StringValue(key.ShareID),
+				KeyID:           stringValueOrNull(key.KeyID),
+				Name:            stringValueOrNull(key.Name),
+				Algorithm:       stringValueOrNull(key.Algorithm),
+				Curve:           stringValueOrNull(key.Curve),
+				KeySize:         types.Int64Value(key.KeySize),
+				Format:          stringValueOrNull(key.Format),
+				PublicKey:       stringValueOrNull(key.PublicKey),
+				EthereumAddress: stringValueOrNull(key.EthereumAddress),
+				Metadata:        metadata,
+				Status:          stringValueOrNull(key.Status),
+				SharedBy:        types.Int64Value(key.SharedBy),
+				SharedAt:        stringValueOrNull(key.SharedAt),
+				ExpiresAt:       stringValueOrNull(key.ExpiresAt),
+			})
+		}
+
+		offset += int64(len(list.Payload.Keys))
+		if len(list.Payload.Keys) == 0 || offset >= list.Payload.Total {
+			break
+		}
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("shared_keys")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
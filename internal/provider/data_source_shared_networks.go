@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/pro"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SharedNetworksDataSource{}
+
+func NewSharedNetworksDataSource() datasource.DataSource {
+	return &SharedNetworksDataSource{}
+}
+
+// SharedNetworksDataSource defines the data source implementation.
+type SharedNetworksDataSource struct {
+	client *Client
+}
+
+// SharedNetworkItem represents a single network shared with this instance
+type SharedNetworkItem struct {
+	ID             types.String `tfsdk:"id"`
+	ResourceID     types.String `tfsdk:"resource_id"`
+	ResourceType   types.String `tfsdk:"resource_type"`
+	Status         types.String `tfsdk:"status"`
+	SharedBy       types.String `tfsdk:"shared_by"`
+	SharedByNodeID types.String `tfsdk:"shared_by_node_id"`
+	ConnectionID   types.Int64  `tfsdk:"connection_id"`
+	DataJSON       types.String `tfsdk:"data_json"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// SharedNetworksDataSourceModel describes the data source data model.
+type SharedNetworksDataSourceModel struct {
+	ID                 types.String        `tfsdk:"id"`
+	StatusFilter       types.String        `tfsdk:"status_filter"`
+	ResourceTypeFilter types.String        `tfsdk:"resource_type_filter"`
+	Networks           []SharedNetworkItem `tfsdk:"networks"`
+}
+
+func (d *SharedNetworksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_networks"
+}
+
+func (d *SharedNetworksDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the Fabric and Besu networks other Chainlaunch instances shared with this one (Chainlaunch Pro), to accept them with chainlaunch_shared_network_acceptance.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Placeholder identifier for the data source.",
+			},
+			"status_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter shares by status (case-insensitive exact match).",
+			},
+			"resource_type_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter shares by the type of the shared network (case-insensitive exact match).",
+			},
+			"networks": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of shared networks matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the share, used as share_id of chainlaunch_shared_network_acceptance.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the network on the instance that shared it.",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the shared network.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the share.",
+						},
+						"shared_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The instance that shared the network.",
+						},
+						"shared_by_node_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The node sharing ID of the instance that shared the network.",
+						},
+						"connection_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the node sharing connection the network was received on.",
+						},
+						"data_json": schema.StringAttribute{
+							Computed:    true,
+							Description: "The shared network data (genesis block, configuration and metadata) as JSON.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the network was shared.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SharedNetworksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SharedNetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SharedNetworksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.API.Pro.ListSharedNetworks(pro.NewListSharedNetworksParamsWithContext(ctx), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared networks, got error: %s", err))
+		return
+	}
+
+	statusFilter := data.StatusFilter.ValueString()
+	resourceTypeFilter := data.ResourceTypeFilter.ValueString()
+
+	data.Networks = []SharedNetworkItem{}
+	for _, share := range list.Payload.Networks {
+		if share == nil {
+			continue
+		}
+		if statusFilter != "" && !strings.EqualFold(share.Status, statusFilter) {
+			continue
+		}
+		if resourceTypeFilter != "" && !strings.EqualFold(share.ResourceType, resourceTypeFilter) {
+			continue
+		}
+
+		item := SharedNetworkItem{
+			ID:             types.StringValue(share.ID),
+			ResourceID:     stringValueOrNull(share.ResourceID),
+			ResourceType:   stringValueOrNull(share.ResourceType),
+			Status:         stringValueOrNull(share.Status),
+			SharedBy:       stringValueOrNull(share.SharedBy),
+			SharedByNodeID: stringValueOrNull(share.SharedByNodeID),
+			ConnectionID:   types.Int64Value(share.ConnectionID),
+			DataJSON:       types.StringNull(),
+			CreatedAt:      stringValueOrNull(share.CreatedAt),
+		}
+		if share.DataJSON != nil {
+			dataJSON, err := json.Marshal(share.DataJSON)
+			if err != nil {
+				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to encode data of shared network %s, got error: %s", share.ID, err))
+				return
+			}
+			item.DataJSON = types.StringValue(string(dataJSON))
+		}
+		data.Networks = append(data.Networks, item)
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("shared_networks")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/users"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UsersDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &UsersDataSource{}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+// UsersDataSource defines the data source implementation.
+type UsersDataSource struct {
+	client *Client
+}
+
+// UserItem represents a single user in the list
+type UserItem struct {
+	ID          types.Int64  `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	Role        types.String `tfsdk:"role"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	LastLoginAt types.String `tfsdk:"last_login_at"`
+}
+
+// UsersDataSourceModel describes the data source data model.
+type UsersDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	UsernameFilter types.String `tfsdk:"username_filter"`
+	RoleFilter     types.String `tfsdk:"role_filter"`
+	Users          []UserItem   `tfsdk:"users"`
+}
+
+func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the Chainlaunch users, optionally filtered by username or role. Listing users requires an ADMIN account.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Placeholder identifier for the data source.",
+			},
+			"username_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter users by username (case-insensitive partial match).",
+			},
+			"role_filter": schema.StringAttribute{
+				Optional:    true,
+				Description: "Filter users by role (ADMIN, OPERATOR, VIEWER, CUSTOM or MCP).",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of users matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The unique identifier of the user.",
+						},
+						"username": schema.StringAttribute{
+							Computed:    true,
+							Description: "The login name of the user.",
+						},
+						"role": schema.StringAttribute{
+							Computed:    true,
+							Description: "The role of the user.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp when the user was created.",
+						},
+						"last_login_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp of the last login of the user.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UsersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var role types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role_filter"), &role)...)
+	if err := validateUserRole(role); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_filter"), "Invalid Role", err.Error())
+	}
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UsersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.API.Users.ListUsers(users.NewListUsersParamsWithContext(ctx), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read users, got error: %s", err))
+		return
+	}
+
+	usernameFilter := data.UsernameFilter.ValueString()
+	roleFilter := data.RoleFilter.ValueString()
+
+	data.Users = []UserItem{}
+	for _, user := range list.Payload {
+		if user == nil {
+			continue
+		}
+		if usernameFilter != "" && !containsIgnoreCase(user.Username, usernameFilter) {
+			continue
+		}
+		if roleFilter != "" && string(user.Role) != roleFilter {
+			continue
+		}
+		data.Users = append(data.Users, UserItem{
+			ID:          types.Int64Value(user.ID),
+			Username:    types.StringValue(user.Username),
+			Role:        types.StringValue(string(user.Role)),
+			CreatedAt:   stringValueOrNull(user.CreatedAt),
+			LastLoginAt: stringValueOrNull(user.LastLoginAt),
+		})
+	}
+
+	// Set placeholder ID
+	data.ID = types.StringValue("users")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
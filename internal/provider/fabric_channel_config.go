@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Fabric policy types as encoded in common.Policy.
+const (
+	fabricPolicyTypeSignature    = 1
+	fabricPolicyTypeImplicitMeta = 3
+)
+
+// FabricChannelConfig is the subset of a live Fabric channel configuration that
+// the provider compares against the fabric_network resource state.
+type FabricChannelConfig struct {
+	ApplicationOrgs         []string
+	OrdererOrgs             []string
+	ConsensusType           string
+	BatchSize               *BatchSize
+	BatchTimeout            string
+	EtcdRaftOptions         *EtcdRaftOptions
+	SmartBFTOptions         *SmartBFTOptions
+	ChannelCapabilities     []string
+	ApplicationCapabilities []string
+	OrdererCapabilities     []string
+	ApplicationPolicies     map[string]FabricPolicy
+	OrdererPolicies         map[string]FabricPolicy
+	ChannelPolicies         map[string]FabricPolicy
+}
+
+// channelConfigGroup mirrors the JSON encoding of a common.ConfigGroup.
+type channelConfigGroup struct {
+	Groups   map[string]channelConfigGroup  `json:"groups"`
+	Values   map[string]channelConfigValue  `json:"values"`
+	Policies map[string]channelConfigPolicy `json:"policies"`
+}
+
+type channelConfigValue struct {
+	Value json.RawMessage `json:"value"`
+}
+
+type channelConfigPolicy struct {
+	Policy struct {
+		Type  int             `json:"type"`
+		Value json.RawMessage `json:"value"`
+	} `json:"policy"`
+}
+
+// flexInt decodes integers that protolator may emit either as JSON numbers or,
+// for 64-bit fields, as quoted strings.
+type flexInt int64
+
+func (f *flexInt) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid integer %s: %w", string(b), err)
+	}
+	*f = flexInt(v)
+	return nil
+}
+
+// ParseFabricChannelConfig decodes the config returned by
+// GET /networks/fabric/{id}/current-channel-config.
+func ParseFabricChannelConfig(raw json.RawMessage) (*FabricChannelConfig, error) {
+	var envelope struct {
+		ChannelGroup *channelConfigGroup `json:"channel_group"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, fmt.Errorf("unable to decode channel config: %w", err)
+	}
+
+	root := envelope.ChannelGroup
+	if root == nil {
+		// Some versions return the channel group itself rather than the full config
+		root = &channelConfigGroup{}
+		if err := json.Unmarshal(raw, root); err != nil {
+			return nil, fmt.Errorf("unable to decode channel group: %w", err)
+		}
+	}
+
+	cfg := &FabricChannelConfig{}
+	var err error
+
+	if cfg.ChannelCapabilities, err = parseCapabilities(root.Values); err != nil {
+		return nil, err
+	}
+	if cfg.ChannelPolicies, err = parsePolicies(root.Policies); err != nil {
+		return nil, err
+	}
+
+	if app, ok := root.Groups["Application"]; ok {
+		cfg.ApplicationOrgs = sortedKeys(app.Groups)
+		if cfg.ApplicationCapabilities, err = parseCapabilities(app.Values); err != nil {
+			return nil, err
+		}
+		if cfg.ApplicationPolicies, err = parsePolicies(app.Policies); err != nil {
+			return nil, err
+		}
+	}
+
+	if orderer, ok := root.Groups["Orderer"]; ok {
+		cfg.OrdererOrgs = sortedKeys(orderer.Groups)
+		if cfg.OrdererCapabilities, err = parseCapabilities(orderer.Values); err != nil {
+			return nil, err
+		}
+		if cfg.OrdererPolicies, err = parsePolicies(orderer.Policies); err != nil {
+			return nil, err
+		}
+		if err := parseOrdererValues(orderer.Values, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	return cfg, nil
+}
+
+func parseOrdererValues(values map[string]channelConfigValue, cfg *FabricChannelConfig) error {
+	if v, ok := values["BatchSize"]; ok {
+		var batchSize struct {
+			MaxMessageCount   flexInt `json:"max_message_count"`
+			AbsoluteMaxBytes  flexInt `json:"absolute_max_bytes"`
+			PreferredMaxBytes flexInt `json:"preferred_max_bytes"`
+		}
+		if err := json.Unmarshal(v.Value, &batchSize); err != nil {
+			return fmt.Errorf("unable to decode BatchSize: %w", err)
+		}
+		cfg.BatchSize = &BatchSize{
+			MaxMessageCount:   int(batchSize.MaxMessageCount),
+			AbsoluteMaxBytes:  int(batchSize.AbsoluteMaxBytes),
+			PreferredMaxBytes: int(batchSize.PreferredMaxBytes),
+		}
+	}
+
+	if v, ok := values["BatchTimeout"]; ok {
+		var batchTimeout struct {
+			Timeout string `json:"timeout"`
+		}
+		if err := json.Unmarshal(v.Value, &batchTimeout); err != nil {
+			return fmt.Errorf("unable to decode BatchTimeout: %w", err)
+		}
+		cfg.BatchTimeout = batchTimeout.Timeout
+	}
+
+	v, ok := values["ConsensusType"]
+	if !ok {
+		return nil
+	}
+
+	var consensus struct {
+		Type     string          `json:"type"`
+		Metadata json.RawMessage `json:"metadata"`
+	}
+	if err := json.Unmarshal(v.Value, &consensus); err != nil {
+		return fmt.Errorf("unable to decode ConsensusType: %w", err)
+	}
+
+	switch consensus.Type {
+	case "etcdraft":
+		cfg.ConsensusType = "etcdraft"
+		var metadata struct {
+			Options *struct {
+				TickInterval         string  `json:"tick_interval"`
+				ElectionTick         flexInt `json:"election_tick"`
+				HeartbeatTick        flexInt `json:"heartbeat_tick"`
+				MaxInflightBlocks    flexInt `json:"max_inflight_blocks"`
+				SnapshotIntervalSize flexInt `json:"snapshot_interval_size"`
+			} `json:"options"`
+		}
+		if len(consensus.Metadata) > 0 {
+			if err := json.Unmarshal(consensus.Metadata, &metadata); err != nil {
+				return fmt.Errorf("unable to decode etcdraft metadata: %w", err)
+			}
+		}
+		if metadata.Options != nil {
+			cfg.EtcdRaftOptions = &EtcdRaftOptions{
+				TickInterval:         metadata.Options.TickInterval,
+				ElectionTick:         int(metadata.Options.ElectionTick),
+				HeartbeatTick:        int(metadata.Options.HeartbeatTick),
+				MaxInflightBlocks:    int(metadata.Options.MaxInflightBlocks),
+				SnapshotIntervalSize: int(metadata.Options.SnapshotIntervalSize),
+			}
+		}
+	case "BFT", "smartbft":
+		cfg.ConsensusType = "smartbft"
+		var opts struct {
+			RequestBatchMaxCount      flexInt `json:"request_batch_max_count"`
+			RequestBatchMaxBytes      flexInt `json:"request_batch_max_bytes"`
+			RequestBatchMaxInterval   string  `json:"request_batch_max_interval"`
+			RequestMaxBytes           flexInt `json:"request_max_bytes"`
+			IncomingMessageBufferSize flexInt `json:"incoming_message_buffer_size"`
+			RequestPoolSize           flexInt `json:"request_pool_size"`
+			ViewChangeResendInterval  string  `json:"view_change_resend_interval"`
+			ViewChangeTimeout         string  `json:"view_change_timeout"`
+			LeaderHeartbeatCount      flexInt `json:"leader_heartbeat_count"`
+			LeaderHeartbeatTimeout    string  `json:"leader_heartbeat_timeout"`
+			CollectTimeout            string  `json:"collect_timeout"`
+			SyncOnStart               bool    `json:"sync_on_start"`
+			SpeedUpViewChange         bool    `json:"speed_up_view_change"`
+			LeaderRotation            string  `json:"leader_rotation"`
+			DecisionsPerLeader        flexInt `json:"decisions_per_leader"`
+			RequestComplainTimeout    string  `json:"request_complain_timeout"`
+			RequestAutoRemoveTimeout  string  `json:"request_auto_remove_timeout"`
+			RequestForwardTimeout     string  `json:"request_forward_timeout"`
+		}
+		if len(consensus.Metadata) > 0 {
+			if err := json.Unmarshal(consensus.Metadata, &opts); err != nil {
+				return fmt.Errorf("unable to decode SmartBFT metadata: %w", err)
+			}
+			cfg.SmartBFTOptions = &SmartBFTOptions{
+				RequestBatchMaxCount:      int(opts.RequestBatchMaxCount),
+				RequestBatchMaxBytes:      int(opts.RequestBatchMaxBytes),
+				RequestBatchMaxInterval:   opts.RequestBatchMaxInterval,
+				RequestMaxBytes:           int(opts.RequestMaxBytes),
+				IncomingMessageBufferSize: int(opts.IncomingMessageBufferSize),
+				RequestPoolSize:           int(opts.RequestPoolSize),
+				ViewChangeResendInterval:  opts.ViewChangeResendInterval,
+				ViewChangeTimeout:         opts.ViewChangeTimeout,
+				LeaderHeartbeatCount:      int(opts.LeaderHeartbeatCount),
+				LeaderHeartbeatTimeout:    opts.LeaderHeartbeatTimeout,
+				CollectTimeout:            opts.CollectTimeout,
+				SyncOnStart:               opts.SyncOnStart,
+				SpeedUpViewChange:         opts.SpeedUpViewChange,
+				LeaderRotation:            opts.LeaderRotation,
+				DecisionsPerLeader:        int(opts.DecisionsPerLeader),
+				RequestComplainTimeout:    opts.RequestComplainTimeout,
+				RequestAutoRemoveTimeout:  opts.RequestAutoRemoveTimeout,
+				RequestForwardTimeout:     opts.RequestForwardTimeout,
+			}
+		}
+	default:
+		cfg.ConsensusType = consensus.Type
+	}
+
+	return nil
+}
+
+func parseCapabilities(values map[string]channelConfigValue) ([]string, error) {
+	v, ok := values["Capabilities"]
+	if !ok {
+		return nil, nil
+	}
+
+	var caps struct {
+		Capabilities map[string]json.RawMessage `json:"capabilities"`
+	}
+	if err := json.Unmarshal(v.Value, &caps); err != nil {
+		return nil, fmt.Errorf("unable to decode Capabilities: %w", err)
+	}
+
+	return sortedKeys(caps.Capabilities), nil
+}
+
+func parsePolicies(policies map[string]channelConfigPolicy) (map[string]FabricPolicy, error) {
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]FabricPolicy, len(policies))
+	for name, p := range policies {
+		switch p.Policy.Type {
+		case fabricPolicyTypeImplicitMeta:
+			var meta struct {
+				Rule      string `json:"rule"`
+				SubPolicy string `json:"sub_policy"`
+			}
+			if err := json.Unmarshal(p.Policy.Value, &meta); err != nil {
+				return nil, fmt.Errorf("unable to decode policy %s: %w", name, err)
+			}
+			result[name] = FabricPolicy{
+				Type: "ImplicitMeta",
+				Rule: fmt.Sprintf("%s %s", meta.Rule, meta.SubPolicy),
+			}
+		case fabricPolicyTypeSignature:
+			rule, err := renderSignaturePolicy(p.Policy.Value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode policy %s: %w", name, err)
+			}
+			result[name] = FabricPolicy{
+				Type: "Signature",
+				Rule: rule,
+			}
+		}
+	}
+
+	return result, nil
+}
+
+type signaturePolicyRule struct {
+	SignedBy *flexInt `json:"signed_by"`
+	NOutOf   *struct {
+		N     flexInt               `json:"n"`
+		Rules []signaturePolicyRule `json:"rules"`
+	} `json:"n_out_of"`
+}
+
+// renderSignaturePolicy converts a SignaturePolicyEnvelope back into Fabric's
+// policy expression language (e.g. "OR('Org1MSP.admin', 'Org2MSP.admin')").
+func renderSignaturePolicy(raw json.RawMessage) (string, error) {
+	var envelope struct {
+		Rule       signaturePolicyRule `json:"rule"`
+		Identities []struct {
+			Principal struct {
+				MSPIdentifier string          `json:"msp_identifier"`
+				Role          json.RawMessage `json:"role"`
+			} `json:"principal"`
+		} `json:"identities"`
+	}
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return "", err
+	}
+
+	principals := make([]string, len(envelope.Identities))
+	for i, id := range envelope.Identities {
+		principals[i] = fmt.Sprintf("'%s.%s'", id.Principal.MSPIdentifier, mspRoleName(id.Principal.Role))
+	}
+
+	return renderSignatureRule(envelope.Rule, principals)
+}
+
+func renderSignatureRule(rule signaturePolicyRule, principals []string) (string, error) {
+	if rule.SignedBy != nil {
+		idx := int(*rule.SignedBy)
+		if idx < 0 || idx >= len(principals) {
+			return "", fmt.Errorf("signed_by index %d out of range", idx)
+		}
+		return principals[idx], nil
+	}
+
+	if rule.NOutOf == nil {
+		return "", fmt.Errorf("signature policy rule has neither signed_by nor n_out_of")
+	}
+
+	parts := make([]string, len(rule.NOutOf.Rules))
+	for i, sub := range rule.NOutOf.Rules {
+		rendered, err := renderSignatureRule(sub, principals)
+		if err != nil {
+			return "", err
+		}
+		parts[i] = rendered
+	}
+
+	n := int(rule.NOutOf.N)
+	switch {
+	case n == 1:
+		return fmt.Sprintf("OR(%s)", strings.Join(parts, ", ")), nil
+	case n == len(parts):
+		return fmt.Sprintf("AND(%s)", strings.Join(parts, ", ")), nil
+	default:
+		return fmt.Sprintf("OutOf(%d, %s)", n, strings.Join(parts, ", ")), nil
+	}
+}
+
+// mspRoleName maps an MSPRole.MSPRoleType (by name or number) to the lower-case
+// form used in policy expressions.
+func mspRoleName(raw json.RawMessage) string {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return strings.ToLower(name)
+	}
+
+	var num int
+	if err := json.Unmarshal(raw, &num); err == nil {
+		switch num {
+		case 1:
+			return "admin"
+		case 2:
+			return "client"
+		case 3:
+			return "peer"
+		case 4:
+			return "orderer"
+		}
+	}
+
+	return "member"
+}
+
+// policyRulesEquivalent compares two policy expressions ignoring whitespace and
+// case, so that a hand-written rule is not reported as drift against the
+// rendering of the same policy.
+func policyRulesEquivalent(a, b string) bool {
+	strip := func(s string) string {
+		return strings.Join(strings.Fields(s), "")
+	}
+	return strings.EqualFold(strip(a), strip(b))
+}
+
+// sameStringSet reports whether a and b contain the same elements regardless of order.
+func sameStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, s := range a {
+		counts[s]++
+	}
+	for _, s := range b {
+		counts[s]--
+		if counts[s] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	if len(m) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
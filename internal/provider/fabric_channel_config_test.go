@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testChannelConfigJSON = `{
+  "channel_group": {
+    "groups": {
+      "Application": {
+        "groups": {
+          "Org2MSP": {},
+          "Org1MSP": {}
+        },
+        "policies": {
+          "Endorsement": {
+            "policy": {
+              "type": 3,
+              "value": {"rule": "MAJORITY", "sub_policy": "Endorsement"}
+            }
+          },
+          "Admins": {
+            "policy": {
+              "type": 1,
+              "value": {
+                "identities": [
+                  {"principal": {"msp_identifier": "Org1MSP", "role": "ADMIN"}, "principal_classification": "ROLE"},
+                  {"principal": {"msp_identifier": "Org2MSP", "role": "ADMIN"}, "principal_classification": "ROLE"}
+                ],
+                "rule": {"n_out_of": {"n": 2, "rules": [{"signed_by": 0}, {"signed_by": 1}]}},
+                "version": 0
+              }
+            }
+          }
+        },
+        "values": {
+          "Capabilities": {"value": {"capabilities": {"V2_5": {}}}}
+        }
+      },
+      "Orderer": {
+        "groups": {
+          "OrdererMSP": {}
+        },
+        "values": {
+          "BatchSize": {"value": {"absolute_max_bytes": 103809024, "max_message_count": 10, "preferred_max_bytes": 524288}},
+          "BatchTimeout": {"value": {"timeout": "1s"}},
+          "Capabilities": {"value": {"capabilities": {"V2_0": {}}}},
+          "ConsensusType": {
+            "value": {
+              "type": "etcdraft",
+              "state": "STATE_NORMAL",
+              "metadata": {
+                "consenters": [],
+                "options": {
+                  "election_tick": 10,
+                  "heartbeat_tick": 1,
+                  "max_inflight_blocks": 5,
+                  "snapshot_interval_size": "16777216",
+                  "tick_interval": "500ms"
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "values": {
+      "Capabilities": {"value": {"capabilities": {"V3_0": {}, "V2_0": {}}}}
+    }
+  }
+}`
+
+func TestParseFabricChannelConfig(t *testing.T) {
+	cfg, err := ParseFabricChannelConfig(json.RawMessage(testChannelConfigJSON))
+	if err != nil {
+		t.Fatalf("ParseFabricChannelConfig returned error: %s", err)
+	}
+
+	if !sameStringSet(cfg.ApplicationOrgs, []string{"Org1MSP", "Org2MSP"}) {
+		t.Errorf("unexpected application orgs: %v", cfg.ApplicationOrgs)
+	}
+	if !sameStringSet(cfg.OrdererOrgs, []string{"OrdererMSP"}) {
+		t.Errorf("unexpected orderer orgs: %v", cfg.OrdererOrgs)
+	}
+	if cfg.ConsensusType != "etcdraft" {
+		t.Errorf("expected consensus type etcdraft, got %q", cfg.ConsensusType)
+	}
+	if cfg.BatchSize == nil || cfg.BatchSize.MaxMessageCount != 10 {
+		t.Errorf("unexpected batch size: %+v", cfg.BatchSize)
+	}
+	if cfg.BatchTimeout != "1s" {
+		t.Errorf("expected batch timeout 1s, got %q", cfg.BatchTimeout)
+	}
+	if cfg.EtcdRaftOptions == nil || cfg.EtcdRaftOptions.SnapshotIntervalSize != 16777216 {
+		t.Errorf("unexpected etcdraft options: %+v", cfg.EtcdRaftOptions)
+	}
+	if !sameStringSet(cfg.ChannelCapabilities, []string{"V2_0", "V3_0"}) {
+		t.Errorf("unexpected channel capabilities: %v", cfg.ChannelCapabilities)
+	}
+	if !sameStringSet(cfg.ApplicationCapabilities, []string{"V2_5"}) {
+		t.Errorf("unexpected application capabilities: %v", cfg.ApplicationCapabilities)
+	}
+
+	endorsement := cfg.ApplicationPolicies["Endorsement"]
+	if endorsement.Type != "ImplicitMeta" || endorsement.Rule != "MAJORITY Endorsement" {
+		t.Errorf("unexpected Endorsement policy: %+v", endorsement)
+	}
+
+	admins := cfg.ApplicationPolicies["Admins"]
+	if admins.Type != "Signature" || admins.Rule != "AND('Org1MSP.admin', 'Org2MSP.admin')" {
+		t.Errorf("unexpected Admins policy: %+v", admins)
+	}
+}
+
+func TestPolicyRulesEquivalent(t *testing.T) {
+	if !policyRulesEquivalent("OR('Org1MSP.member','Org2MSP.member')", "OR('Org1MSP.member', 'Org2MSP.member')") {
+		t.Error("expected rules differing only in whitespace to be equivalent")
+	}
+	if policyRulesEquivalent("OR('Org1MSP.member')", "OR('Org2MSP.member')") {
+		t.Error("expected rules with different principals to differ")
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers of the Fabric messages on the path from a block to the
+// organization groups of its channel configuration (common/common.proto and
+// common/configtx.proto).
+const (
+	blockDataField            protowire.Number = 2 // Block.data
+	blockDataDataField        protowire.Number = 1 // BlockData.data
+	envelopePayloadField      protowire.Number = 1 // Envelope.payload
+	payloadDataField          protowire.Number = 2 // Payload.data
+	configEnvelopeConfigField protowire.Number = 1 // ConfigEnvelope.config
+	configChannelGroupField   protowire.Number = 2 // Config.channel_group
+	configGroupGroupsField    protowire.Number = 2 // ConfigGroup.groups
+	protoMapEntryKeyField     protowire.Number = 1
+	protoMapEntryValueField   protowire.Number = 2
+)
+
+// decodeConfigBlockMSPIDs returns the MSP IDs of the application and orderer
+// organizations in the channel configuration held by a genesis or config
+// block. Like ParseFabricChannelConfig, organizations are identified by the
+// name of their config group.
+func decodeConfigBlockMSPIDs(block []byte) (members, orderers []string, err error) {
+	msg := block
+	for _, step := range []struct {
+		field protowire.Number
+		name  string
+	}{
+		{blockDataField, "block data"},
+		{blockDataDataField, "envelope"},
+		{envelopePayloadField, "envelope payload"},
+		{payloadDataField, "config envelope"},
+		{configEnvelopeConfigField, "channel config"},
+		{configChannelGroupField, "channel group"},
+	} {
+		var found bool
+		msg, found, err = protoBytesField(msg, step.field)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to decode %s: %w", step.name, err)
+		}
+		if !found {
+			return nil, nil, fmt.Errorf("block has no %s", step.name)
+		}
+	}
+
+	channelGroups, err := configSubgroups(msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode channel group: %w", err)
+	}
+	applicationGroups, err := configSubgroups(channelGroups["Application"])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode application group: %w", err)
+	}
+	ordererGroups, err := configSubgroups(channelGroups["Orderer"])
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to decode orderer group: %w", err)
+	}
+
+	return sortedKeys(applicationGroups), sortedKeys(ordererGroups), nil
+}
+
+// configSubgroups decodes the groups map of an encoded common.ConfigGroup.
+func configSubgroups(group []byte) (map[string][]byte, error) {
+	groups := map[string][]byte{}
+	err := protoBytesFields(group, configGroupGroupsField, func(entry []byte) error {
+		key, _, err := protoBytesField(entry, protoMapEntryKeyField)
+		if err != nil {
+			return err
+		}
+		value, _, err := protoBytesField(entry, protoMapEntryValueField)
+		if err != nil {
+			return err
+		}
+		groups[string(key)] = value
+		return nil
+	})
+	return groups, err
+}
+
+// protoBytesField returns the first length-delimited field numbered num of the
+// encoded message msg, and whether it is present.
+func protoBytesField(msg []byte, num protowire.Number) ([]byte, bool, error) {
+	var value []byte
+	found := false
+	err := protoBytesFields(msg, num, func(v []byte) error {
+		if !found {
+			value, found = v, true
+		}
+		return nil
+	})
+	return value, found, err
+}
+
+// protoBytesFields calls fn with every length-delimited field numbered num of
+// the encoded message msg, skipping the other fields.
+func protoBytesFields(msg []byte, num protowire.Number, fn func([]byte) error) error {
+	for len(msg) > 0 {
+		n, typ, l := protowire.ConsumeTag(msg)
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		msg = msg[l:]
+
+		if n == num && typ == protowire.BytesType {
+			v, l := protowire.ConsumeBytes(msg)
+			if l < 0 {
+				return protowire.ParseError(l)
+			}
+			if err := fn(v); err != nil {
+				return err
+			}
+			msg = msg[l:]
+			continue
+		}
+
+		l = protowire.ConsumeFieldValue(n, typ, msg)
+		if l < 0 {
+			return protowire.ParseError(l)
+		}
+		msg = msg[l:]
+	}
+	return nil
+}
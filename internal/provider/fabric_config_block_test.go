@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testProtoBytes encodes a length-delimited protobuf field.
+func testProtoBytes(num protowire.Number, value []byte) []byte {
+	b := protowire.AppendTag(nil, num, protowire.BytesType)
+	return protowire.AppendBytes(b, value)
+}
+
+// testConfigGroup encodes a common.ConfigGroup with the given subgroups.
+func testConfigGroup(groups map[string][]byte) []byte {
+	// A version field, which the decoder has to skip
+	b := protowire.AppendTag(nil, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1)
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		entry := append(testProtoBytes(1, []byte(name)), testProtoBytes(2, groups[name])...)
+		b = append(b, testProtoBytes(2, entry)...)
+	}
+	return b
+}
+
+// testConfigBlock encodes a config block whose channel group has the given
+// application and orderer organizations.
+func testConfigBlock(members, orderers []string) []byte {
+	orgs := func(names []string) []byte {
+		groups := map[string][]byte{}
+		for _, name := range names {
+			groups[name] = nil
+		}
+		return testConfigGroup(groups)
+	}
+	channelGroup := testConfigGroup(map[string][]byte{
+		"Application": orgs(members),
+		"Orderer":     orgs(orderers),
+	})
+
+	config := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 3)
+	config = append(config, testProtoBytes(2, channelGroup)...)
+	payload := append(testProtoBytes(1, []byte("header")), testProtoBytes(2, testProtoBytes(1, config))...)
+	envelope := append(testProtoBytes(1, payload), testProtoBytes(2, []byte("signature"))...)
+
+	block := testProtoBytes(1, []byte("header"))
+	block = append(block, testProtoBytes(2, testProtoBytes(1, envelope))...)
+	return append(block, testProtoBytes(3, []byte("metadata"))...)
+}
+
+func TestDecodeConfigBlockMSPIDs(t *testing.T) {
+	members, orderers, err := decodeConfigBlockMSPIDs(testConfigBlock([]string{"Org2MSP", "Org1MSP"}, []string{"OrdererMSP"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"Org1MSP", "Org2MSP"}; !reflect.DeepEqual(members, want) {
+		t.Errorf("members = %v, want %v", members, want)
+	}
+	if want := []string{"OrdererMSP"}; !reflect.DeepEqual(orderers, want) {
+		t.Errorf("orderers = %v, want %v", orderers, want)
+	}
+
+	if _, _, err := decodeConfigBlockMSPIDs(testProtoBytes(1, []byte("header"))); err == nil {
+		t.Error("expected an error for a block without data")
+	}
+	if _, _, err := decodeConfigBlockMSPIDs([]byte("not a block")); err == nil {
+		t.Error("expected an error for a malformed block")
+	}
+}
+
+// TestFabricNetworkImportReadDecodesBlock checks that the member MSPs of a
+// channel imported from a block are known without a local peer on the channel.
+func TestFabricNetworkImportReadDecodesBlock(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/networks/fabric/4" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":4,"name":"consortium","status":"genesis_block_created"}`))
+	}))
+	defer server.Close()
+
+	r := &FabricNetworkImportResource{client: NewClient(server.URL, "", "test", "test")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &FabricNetworkImportResourceModel{
+		ID:                 types.Int64Value(4),
+		GenesisBlockBase64: types.StringValue(base64.StdEncoding.EncodeToString(testConfigBlock([]string{"Org1MSP", "Org2MSP"}, []string{"OrdererMSP"}))),
+		GenesisBlockPath:   types.StringNull(),
+		OrganizationID:     types.Int64Null(),
+		ChannelID:          types.StringNull(),
+		OrdererURL:         types.StringNull(),
+		OrdererTLSCert:     types.StringNull(),
+		Description:        types.StringNull(),
+		Name:               types.StringValue("consortium"),
+		Status:             types.StringNull(),
+		MemberMSPIDs:       types.ListNull(types.StringType),
+		OrdererMSPIDs:      types.ListNull(types.StringType),
+		CreatedAt:          types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unable to build state: %v", diags)
+	}
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if len(readResp.Diagnostics) > 0 {
+		t.Fatalf("Read returned diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var got FabricNetworkImportResourceModel
+	if diags := readResp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	var members, orderers []string
+	got.MemberMSPIDs.ElementsAs(ctx, &members, false)
+	got.OrdererMSPIDs.ElementsAs(ctx, &orderers, false)
+	if want := []string{"Org1MSP", "Org2MSP"}; !reflect.DeepEqual(members, want) {
+		t.Errorf("member_msp_ids = %v, want %v", members, want)
+	}
+	if want := []string{"OrdererMSP"}; !reflect.DeepEqual(orderers, want) {
+		t.Errorf("orderer_msp_ids = %v, want %v", orderers, want)
+	}
+}
@@ -0,0 +1,442 @@
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+// Channel config update operation types accepted by /networks/fabric/{id}/update-config
+const (
+	configUpdateAddOrg                = "add_org"
+	configUpdateRemoveOrg             = "remove_org"
+	configUpdateUpdateOrgMSP          = "update_org_msp"
+	configUpdateBatchSize             = "update_batch_size"
+	configUpdateBatchTimeout          = "update_batch_timeout"
+	configUpdateEtcdRaftOptions       = "update_etcd_raft_options"
+	configUpdateChannelCapability     = "update_channel_capability"
+	configUpdateOrdererCapability     = "update_orderer_capability"
+	configUpdateApplicationCapability = "update_application_capability"
+	configUpdateApplicationPolicy     = "update_application_policy"
+	configUpdateOrdererPolicy         = "update_orderer_policy"
+	configUpdateChannelPolicy         = "update_channel_policy"
+)
+
+// buildConfigUpdateOperations turns the difference between the applied (current)
+// and desired network configuration into an ordered list of channel config
+// update operations. orgs resolves Chainlaunch organization IDs to their MSP ID
+// and CA certificates. Changes that cannot be expressed as config update
+// operations are reported as an error before anything is submitted.
+func buildConfigUpdateOperations(current, desired FabricNetworkConfig, orgs map[int64]*models.HandlerOrganizationResponse) ([]ConfigUpdateOperation, error) {
+	if unsupported := unsupportedConfigChanges(current, desired); len(unsupported) > 0 {
+		return nil, fmt.Errorf("the following attributes cannot be changed on an existing channel: %s", strings.Join(unsupported, ", "))
+	}
+
+	var ops []ConfigUpdateOperation
+
+	orgOps, err := organizationOperations(current, desired, orgs)
+	if err != nil {
+		return nil, err
+	}
+	ops = append(ops, orgOps...)
+
+	// Orderer settings
+	if desired.BatchSize != nil && (current.BatchSize == nil || *current.BatchSize != *desired.BatchSize) {
+		ops = append(ops, ConfigUpdateOperation{
+			Type: configUpdateBatchSize,
+			Payload: UpdateBatchSizePayload{
+				MaxMessageCount:   desired.BatchSize.MaxMessageCount,
+				AbsoluteMaxBytes:  desired.BatchSize.AbsoluteMaxBytes,
+				PreferredMaxBytes: desired.BatchSize.PreferredMaxBytes,
+			},
+		})
+	}
+	if desired.BatchTimeout != "" && !equivalentDurations(current.BatchTimeout, desired.BatchTimeout) {
+		ops = append(ops, ConfigUpdateOperation{
+			Type:    configUpdateBatchTimeout,
+			Payload: UpdateBatchTimeoutPayload{Timeout: desired.BatchTimeout},
+		})
+	}
+	if desired.EtcdRaftOptions != nil && !equivalentEtcdRaftOptions(current.EtcdRaftOptions, desired.EtcdRaftOptions) {
+		opts := desired.EtcdRaftOptions
+		ops = append(ops, ConfigUpdateOperation{
+			Type: configUpdateEtcdRaftOptions,
+			Payload: UpdateEtcdRaftOptionsPayload{
+				TickInterval:         opts.TickInterval,
+				ElectionTick:         opts.ElectionTick,
+				HeartbeatTick:        opts.HeartbeatTick,
+				MaxInflightBlocks:    opts.MaxInflightBlocks,
+				SnapshotIntervalSize: opts.SnapshotIntervalSize,
+			},
+		})
+	}
+
+	// Capabilities
+	ops = appendCapabilityOperation(ops, configUpdateChannelCapability, current.ChannelCapabilities, desired.ChannelCapabilities)
+	ops = appendCapabilityOperation(ops, configUpdateOrdererCapability, current.OrdererCapabilities, desired.OrdererCapabilities)
+	ops = appendCapabilityOperation(ops, configUpdateApplicationCapability, current.ApplicationCapabilities, desired.ApplicationCapabilities)
+
+	// Policies
+	ops = appendPolicyOperations(ops, configUpdateApplicationPolicy, current.ApplicationPolicies, desired.ApplicationPolicies)
+	ops = appendPolicyOperations(ops, configUpdateOrdererPolicy, current.OrdererPolicies, desired.OrdererPolicies)
+	ops = appendPolicyOperations(ops, configUpdateChannelPolicy, current.ChannelPolicies, desired.ChannelPolicies)
+
+	return ops, nil
+}
+
+// unsupportedConfigChanges lists the attributes whose change has no matching
+// config update operation.
+func unsupportedConfigChanges(current, desired FabricNetworkConfig) []string {
+	var unsupported []string
+
+	if !sameOrganizationIDs(current.OrdererOrganizations, desired.OrdererOrganizations) {
+		unsupported = append(unsupported, "orderer_organizations")
+	}
+	if !reflect.DeepEqual(current.ExternalOrdererOrgs, desired.ExternalOrdererOrgs) {
+		unsupported = append(unsupported, "external_orderer_orgs")
+	}
+	if desired.ConsensusType != "" && current.ConsensusType != "" && desired.ConsensusType != current.ConsensusType {
+		unsupported = append(unsupported, "consensus_type")
+	}
+	if desired.SmartBFTOptions != nil && !reflect.DeepEqual(current.SmartBFTOptions, desired.SmartBFTOptions) {
+		unsupported = append(unsupported, "smartbft_options")
+	}
+	if !reflect.DeepEqual(current.SmartBFTConsenters, desired.SmartBFTConsenters) {
+		unsupported = append(unsupported, "smartbft_consenters")
+	}
+	// No config update operation removes a policy, so a managed policy map may
+	// only add or change entries
+	if droppedPolicies(current.ApplicationPolicies, desired.ApplicationPolicies) {
+		unsupported = append(unsupported, "application_policies")
+	}
+	if droppedPolicies(current.OrdererPolicies, desired.OrdererPolicies) {
+		unsupported = append(unsupported, "orderer_policies")
+	}
+	if droppedPolicies(current.ChannelPolicies, desired.ChannelPolicies) {
+		unsupported = append(unsupported, "channel_policies")
+	}
+
+	return unsupported
+}
+
+// organizationOperations adds, updates and removes application organizations.
+// Additions come first so that the channel never ends up without members.
+func organizationOperations(current, desired FabricNetworkConfig, orgs map[int64]*models.HandlerOrganizationResponse) ([]ConfigUpdateOperation, error) {
+	var adds, updates, removes []ConfigUpdateOperation
+
+	currentIDs := make(map[int64]bool, len(current.PeerOrganizations))
+	for _, org := range current.PeerOrganizations {
+		currentIDs[org.ID] = true
+	}
+	desiredIDs := make(map[int64]bool, len(desired.PeerOrganizations))
+	for _, org := range desired.PeerOrganizations {
+		desiredIDs[org.ID] = true
+	}
+
+	for _, org := range desired.PeerOrganizations {
+		if currentIDs[org.ID] {
+			continue
+		}
+		organization, ok := orgs[org.ID]
+		if !ok {
+			return nil, fmt.Errorf("organization %d could not be resolved", org.ID)
+		}
+		if organization.SignCertificate == "" || organization.TLSCertificate == "" {
+			return nil, fmt.Errorf("organization %d (%s) has no CA certificates", org.ID, organization.MspID)
+		}
+		adds = append(adds, ConfigUpdateOperation{
+			Type: configUpdateAddOrg,
+			Payload: OrgMSPPayload{
+				MSPID:        organization.MspID,
+				RootCerts:    []string{organization.SignCertificate},
+				TLSRootCerts: []string{organization.TLSCertificate},
+			},
+		})
+	}
+	for _, org := range current.PeerOrganizations {
+		if desiredIDs[org.ID] {
+			continue
+		}
+		organization, ok := orgs[org.ID]
+		if !ok {
+			return nil, fmt.Errorf("organization %d could not be resolved", org.ID)
+		}
+		removes = append(removes, ConfigUpdateOperation{
+			Type:    configUpdateRemoveOrg,
+			Payload: RemoveOrgPayload{MSPID: organization.MspID},
+		})
+	}
+
+	currentExternal := make(map[string]ExternalOrgConfig, len(current.ExternalPeerOrgs))
+	for _, org := range current.ExternalPeerOrgs {
+		currentExternal[org.MSPID] = org
+	}
+	desiredExternal := make(map[string]bool, len(desired.ExternalPeerOrgs))
+	for _, org := range desired.ExternalPeerOrgs {
+		desiredExternal[org.MSPID] = true
+
+		payload := OrgMSPPayload{
+			MSPID:        org.MSPID,
+			RootCerts:    []string{org.SignCACert},
+			TLSRootCerts: []string{org.TLSCACert},
+		}
+		existing, ok := currentExternal[org.MSPID]
+		switch {
+		case !ok:
+			adds = append(adds, ConfigUpdateOperation{Type: configUpdateAddOrg, Payload: payload})
+		case existing.SignCACert != org.SignCACert || existing.TLSCACert != org.TLSCACert:
+			updates = append(updates, ConfigUpdateOperation{Type: configUpdateUpdateOrgMSP, Payload: payload})
+		}
+	}
+	for _, org := range current.ExternalPeerOrgs {
+		if !desiredExternal[org.MSPID] {
+			removes = append(removes, ConfigUpdateOperation{
+				Type:    configUpdateRemoveOrg,
+				Payload: RemoveOrgPayload{MSPID: org.MSPID},
+			})
+		}
+	}
+
+	ops := append(adds, updates...)
+	return append(ops, removes...), nil
+}
+
+// appendCapabilityOperation replaces a capability set when the desired set is
+// managed and differs from the current one.
+func appendCapabilityOperation(ops []ConfigUpdateOperation, opType string, current, desired []string) []ConfigUpdateOperation {
+	if desired == nil || sameStringSet(current, desired) {
+		return ops
+	}
+	return append(ops, ConfigUpdateOperation{
+		Type:    opType,
+		Payload: UpdateCapabilityPayload{Capability: desired},
+	})
+}
+
+// droppedPolicies reports whether a managed (non-nil) desired policy map lacks
+// a policy of the current one. Unmanaged maps leave the policies untouched.
+func droppedPolicies(current, desired map[string]FabricPolicy) bool {
+	if desired == nil {
+		return false
+	}
+	for name := range current {
+		if _, ok := desired[name]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// appendPolicyOperations emits one operation per added or changed policy, in
+// name order.
+func appendPolicyOperations(ops []ConfigUpdateOperation, opType string, current, desired map[string]FabricPolicy) []ConfigUpdateOperation {
+	for _, name := range sortedKeys(desired) {
+		policy := desired[name]
+		existing, ok := current[name]
+		if ok && strings.EqualFold(existing.Type, policy.Type) && policyRulesEquivalent(existing.Rule, policy.Rule) {
+			continue
+		}
+		ops = append(ops, ConfigUpdateOperation{
+			Type:    opType,
+			Payload: UpdatePolicyPayload{PolicyName: name, Policy: policy},
+		})
+	}
+	return ops
+}
+
+// signingOrgIDs returns the Chainlaunch organizations that are already members
+// of the channel and can therefore sign the config update.
+func signingOrgIDs(current FabricNetworkConfig) []int64 {
+	seen := make(map[int64]bool)
+	var ids []int64
+	for _, orgList := range [][]OrganizationConfig{current.PeerOrganizations, current.OrdererOrganizations} {
+		for _, org := range orgList {
+			if !seen[org.ID] {
+				seen[org.ID] = true
+				ids = append(ids, org.ID)
+			}
+		}
+	}
+	return ids
+}
+
+func sameOrganizationIDs(a, b []OrganizationConfig) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ids := make(map[int64]bool, len(a))
+	for _, org := range a {
+		ids[org.ID] = true
+	}
+	for _, org := range b {
+		if !ids[org.ID] {
+			return false
+		}
+	}
+	return true
+}
+
+func equivalentEtcdRaftOptions(a, b *EtcdRaftOptions) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return equivalentDurations(a.TickInterval, b.TickInterval) &&
+		a.ElectionTick == b.ElectionTick &&
+		a.HeartbeatTick == b.HeartbeatTick &&
+		a.MaxInflightBlocks == b.MaxInflightBlocks &&
+		a.SnapshotIntervalSize == b.SnapshotIntervalSize
+}
+
+// equivalentDurations reports whether two duration strings denote the same
+// duration, e.g. "2s" and "2000ms".
+func equivalentDurations(a, b string) bool {
+	if a == b {
+		return true
+	}
+	da, errA := time.ParseDuration(a)
+	db, errB := time.ParseDuration(b)
+	return errA == nil && errB == nil && da == db
+}
+
+// ConfigUpdateOperation is a single channel config update operation. Payload
+// holds one of the payload types below, chosen by Type.
+type ConfigUpdateOperation struct {
+	Type    string      `json:"type"`
+	Payload interface{} `json:"payload"`
+}
+
+// Config update operation payloads
+type OrgMSPPayload struct {
+	MSPID        string   `json:"msp_id"`
+	RootCerts    []string `json:"root_certs"`
+	TLSRootCerts []string `json:"tls_root_certs"`
+}
+
+type RemoveOrgPayload struct {
+	MSPID string `json:"msp_id"`
+}
+
+type UpdateBatchSizePayload struct {
+	MaxMessageCount   int `json:"max_message_count"`
+	AbsoluteMaxBytes  int `json:"absolute_max_bytes"`
+	PreferredMaxBytes int `json:"preferred_max_bytes"`
+}
+
+type UpdateBatchTimeoutPayload struct {
+	Timeout string `json:"timeout"`
+}
+
+type UpdateEtcdRaftOptionsPayload struct {
+	TickInterval         string `json:"tick_interval"`
+	ElectionTick         int    `json:"election_tick"`
+	HeartbeatTick        int    `json:"heartbeat_tick"`
+	MaxInflightBlocks    int    `json:"max_inflight_blocks"`
+	SnapshotIntervalSize int    `json:"snapshot_interval_size"`
+}
+
+type UpdateCapabilityPayload struct {
+	Capability []string `json:"capability"`
+}
+
+type UpdatePolicyPayload struct {
+	PolicyName string       `json:"policy_name"`
+	Policy     FabricPolicy `json:"policy"`
+}
+
+// FabricNetworkConfig is the channel configuration of a Fabric network as sent
+// when creating it and compared by buildConfigUpdateOperations. It mirrors
+// models.HTTPFabricNetworkConfig, which embeds the batch size by value and so
+// would always send one.
+type FabricNetworkConfig struct {
+	PeerOrganizations       []OrganizationConfig    `json:"peerOrganizations,omitempty"`
+	OrdererOrganizations    []OrganizationConfig    `json:"ordererOrganizations,omitempty"`
+	ExternalPeerOrgs        []ExternalOrgConfig     `json:"externalPeerOrgs,omitempty"`
+	ExternalOrdererOrgs     []ExternalOrgConfig     `json:"externalOrdererOrgs,omitempty"`
+	ConsensusType           string                  `json:"consensusType,omitempty"`
+	EtcdRaftOptions         *EtcdRaftOptions        `json:"etcdRaftOptions,omitempty"`
+	SmartBFTOptions         *SmartBFTOptions        `json:"smartBFTOptions,omitempty"`
+	SmartBFTConsenters      []SmartBFTConsenter     `json:"smartBFTConsenters,omitempty"`
+	ChannelCapabilities     []string                `json:"channelCapabilities,omitempty"`
+	ApplicationCapabilities []string                `json:"applicationCapabilities,omitempty"`
+	OrdererCapabilities     []string                `json:"ordererCapabilities,omitempty"`
+	BatchSize               *BatchSize              `json:"batchSize,omitempty"`
+	BatchTimeout            string                  `json:"batchTimeout,omitempty"`
+	ApplicationPolicies     map[string]FabricPolicy `json:"applicationPolicies,omitempty"`
+	OrdererPolicies         map[string]FabricPolicy `json:"ordererPolicies,omitempty"`
+	ChannelPolicies         map[string]FabricPolicy `json:"channelPolicies,omitempty"`
+}
+
+type OrganizationConfig struct {
+	ID      int64   `json:"id"`
+	NodeIDs []int64 `json:"nodeIds"`
+}
+
+type ExternalOrgConfig struct {
+	MSPID      string            `json:"mspid"`
+	SignCACert string            `json:"signCACert"`
+	TLSCACert  string            `json:"tlsCACert"`
+	Consenters []ConsenterConfig `json:"consenters,omitempty"`
+}
+
+type ConsenterConfig struct {
+	Host    string `json:"host"`
+	Port    int64  `json:"port"`
+	TLSCert string `json:"tlsCert,omitempty"`
+}
+
+type BatchSize struct {
+	MaxMessageCount   int `json:"maxMessageCount,omitempty"`
+	AbsoluteMaxBytes  int `json:"absoluteMaxBytes,omitempty"`
+	PreferredMaxBytes int `json:"preferredMaxBytes,omitempty"`
+}
+
+type EtcdRaftOptions struct {
+	TickInterval         string `json:"tickInterval,omitempty"`
+	ElectionTick         int    `json:"electionTick,omitempty"`
+	HeartbeatTick        int    `json:"heartbeatTick,omitempty"`
+	MaxInflightBlocks    int    `json:"maxInflightBlocks,omitempty"`
+	SnapshotIntervalSize int    `json:"snapshotIntervalSize,omitempty"`
+}
+
+type SmartBFTOptions struct {
+	RequestBatchMaxCount      int    `json:"requestBatchMaxCount,omitempty"`
+	RequestBatchMaxBytes      int    `json:"requestBatchMaxBytes,omitempty"`
+	RequestBatchMaxInterval   string `json:"requestBatchMaxInterval,omitempty"`
+	RequestMaxBytes           int    `json:"requestMaxBytes,omitempty"`
+	IncomingMessageBufferSize int    `json:"incomingMessageBufferSize,omitempty"`
+	RequestPoolSize           int    `json:"requestPoolSize,omitempty"`
+	ViewChangeResendInterval  string `json:"viewChangeResendInterval,omitempty"`
+	ViewChangeTimeout         string `json:"viewChangeTimeout,omitempty"`
+	LeaderHeartbeatCount      int    `json:"leaderHeartbeatCount,omitempty"`
+	LeaderHeartbeatTimeout    string `json:"leaderHeartbeatTimeout,omitempty"`
+	CollectTimeout            string `json:"collectTimeout,omitempty"`
+	SyncOnStart               bool   `json:"syncOnStart,omitempty"`
+	SpeedUpViewChange         bool   `json:"speedUpViewChange,omitempty"`
+	LeaderRotation            string `json:"leaderRotation,omitempty"`
+	DecisionsPerLeader        int    `json:"decisionsPerLeader,omitempty"`
+	RequestComplainTimeout    string `json:"requestComplainTimeout,omitempty"`
+	RequestAutoRemoveTimeout  string `json:"requestAutoRemoveTimeout,omitempty"`
+	RequestForwardTimeout     string `json:"requestForwardTimeout,omitempty"`
+}
+
+type SmartBFTConsenter struct {
+	ID            int64    `json:"id"`
+	MSPID         string   `json:"mspId"`
+	Identity      string   `json:"identity"`
+	ClientTLSCert string   `json:"clientTLSCert"`
+	ServerTLSCert string   `json:"serverTLSCert"`
+	Address       HostPort `json:"address"`
+}
+
+type HostPort struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type FabricPolicy struct {
+	Type string `json:"type"`
+	Rule string `json:"rule"`
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+func testAppliedNetworkConfig() FabricNetworkConfig {
+	return FabricNetworkConfig{
+		PeerOrganizations:    []OrganizationConfig{{ID: 1, NodeIDs: []int64{10}}},
+		OrdererOrganizations: []OrganizationConfig{{ID: 2, NodeIDs: []int64{20}}},
+		ExternalPeerOrgs: []ExternalOrgConfig{
+			{MSPID: "ExtMSP", SignCACert: "sign", TLSCACert: "tls"},
+		},
+		ConsensusType:       "etcdraft",
+		BatchSize:           &BatchSize{MaxMessageCount: 500, AbsoluteMaxBytes: 103809024, PreferredMaxBytes: 524288},
+		BatchTimeout:        "2s",
+		EtcdRaftOptions:     &EtcdRaftOptions{TickInterval: "500ms", ElectionTick: 10, HeartbeatTick: 1, MaxInflightBlocks: 5, SnapshotIntervalSize: 20971520},
+		ChannelCapabilities: []string{"V2_0"},
+		ApplicationPolicies: map[string]FabricPolicy{
+			"Endorsement": {Type: "ImplicitMeta", Rule: "MAJORITY Endorsement"},
+		},
+	}
+}
+
+func TestBuildConfigUpdateOperationsNoChanges(t *testing.T) {
+	current := testAppliedNetworkConfig()
+	desired := testAppliedNetworkConfig()
+	desired.BatchTimeout = "2000ms"
+	desired.PeerOrganizations[0].NodeIDs = []int64{10, 11}
+
+	ops, err := buildConfigUpdateOperations(current, desired, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ops) != 0 {
+		t.Errorf("expected no operations, got %+v", ops)
+	}
+}
+
+func TestBuildConfigUpdateOperationsOrder(t *testing.T) {
+	current := testAppliedNetworkConfig()
+	desired := testAppliedNetworkConfig()
+	desired.PeerOrganizations = []OrganizationConfig{{ID: 3, NodeIDs: []int64{}}}
+	desired.ExternalPeerOrgs[0].TLSCACert = "tls-rotated"
+	desired.BatchTimeout = "5s"
+	desired.EtcdRaftOptions = &EtcdRaftOptions{TickInterval: "500ms", ElectionTick: 20, HeartbeatTick: 2, MaxInflightBlocks: 5, SnapshotIntervalSize: 20971520}
+	desired.ChannelCapabilities = []string{"V3_0"}
+	desired.ApplicationPolicies = map[string]FabricPolicy{
+		"Endorsement": {Type: "ImplicitMeta", Rule: "ANY Endorsement"},
+		"Admins":      {Type: "Signature", Rule: "OR('Org3MSP.admin')"},
+	}
+
+	orgs := map[int64]*models.HandlerOrganizationResponse{
+		1: {ID: 1, MspID: "Org1MSP"},
+		3: {ID: 3, MspID: "Org3MSP", SignCertificate: "org3-sign", TLSCertificate: "org3-tls"},
+	}
+
+	ops, err := buildConfigUpdateOperations(current, desired, orgs)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		configUpdateAddOrg,
+		configUpdateUpdateOrgMSP,
+		configUpdateRemoveOrg,
+		configUpdateBatchTimeout,
+		configUpdateEtcdRaftOptions,
+		configUpdateChannelCapability,
+		configUpdateApplicationPolicy,
+		configUpdateApplicationPolicy,
+	}
+	if len(ops) != len(expected) {
+		t.Fatalf("expected %d operations, got %d: %+v", len(expected), len(ops), ops)
+	}
+	for i, op := range ops {
+		if op.Type != expected[i] {
+			t.Errorf("operation %d: expected %s, got %s", i, expected[i], op.Type)
+		}
+	}
+
+	if payload := ops[0].Payload.(OrgMSPPayload); payload.MSPID != "Org3MSP" || payload.RootCerts[0] != "org3-sign" {
+		t.Errorf("unexpected add_org payload: %+v", payload)
+	}
+	if payload := ops[2].Payload.(RemoveOrgPayload); payload.MSPID != "Org1MSP" {
+		t.Errorf("unexpected remove_org payload: %+v", payload)
+	}
+	if payload := ops[3].Payload.(UpdateBatchTimeoutPayload); payload.Timeout != "5s" {
+		t.Errorf("unexpected update_batch_timeout payload: %+v", payload)
+	}
+	// Policies are emitted in name order
+	if payload := ops[6].Payload.(UpdatePolicyPayload); payload.PolicyName != "Admins" {
+		t.Errorf("expected Admins policy first, got %s", payload.PolicyName)
+	}
+}
+
+func TestBuildConfigUpdateOperationsUnsupported(t *testing.T) {
+	current := testAppliedNetworkConfig()
+	desired := testAppliedNetworkConfig()
+	desired.ConsensusType = "smartbft"
+	desired.OrdererOrganizations = []OrganizationConfig{{ID: 4, NodeIDs: []int64{40}}}
+	desired.ApplicationPolicies = map[string]FabricPolicy{
+		"Admins": {Type: "ImplicitMeta", Rule: "MAJORITY Admins"},
+	}
+
+	_, err := buildConfigUpdateOperations(current, desired, nil)
+	if err == nil {
+		t.Fatal("expected an error for unsupported changes")
+	}
+	for _, attr := range []string{"orderer_organizations", "consensus_type", "application_policies"} {
+		if !strings.Contains(err.Error(), attr) {
+			t.Errorf("expected error to mention %s, got: %s", attr, err)
+		}
+	}
+}
+
+func TestBuildConfigUpdateOperationsUnmanagedPolicies(t *testing.T) {
+	current := testAppliedNetworkConfig()
+	desired := testAppliedNetworkConfig()
+	desired.ApplicationPolicies = nil
+
+	ops, err := buildConfigUpdateOperations(current, desired, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(ops) != 0 {
+		t.Errorf("expected no operations, got %+v", ops)
+	}
+}
+
+func TestSigningOrgIDs(t *testing.T) {
+	cfg := FabricNetworkConfig{
+		PeerOrganizations:    []OrganizationConfig{{ID: 1}, {ID: 2}},
+		OrdererOrganizations: []OrganizationConfig{{ID: 2}, {ID: 3}},
+	}
+
+	ids := signingOrgIDs(cfg)
+	if len(ids) != 3 || ids[0] != 1 || ids[1] != 2 || ids[2] != 3 {
+		t.Errorf("unexpected signing org IDs: %v", ids)
+	}
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// logSubsystem is the tflog subsystem of Chainlaunch API requests. Its level can
+// be set apart from the provider's with TF_LOG_PROVIDER_CHAINLAUNCH_API.
+const logSubsystem = "api"
+
+// logBodiesEnv enables body logging for a comma separated list of API areas,
+// named after the first path segment below /api/v1 (e.g. "nodes,networks"),
+// or for all of them with "all". Bodies are never logged otherwise.
+const logBodiesEnv = "CHAINLAUNCH_LOG_BODIES"
+
+// redactedValue replaces sensitive values in logs
+const redactedValue = "***"
+
+// Headers whose values are never logged
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+}
+
+// Fragments of JSON keys whose values are never logged, compared against the
+// key in lower case with '_' and '-' removed
+var sensitiveKeyFragments = []string{
+	"password",
+	"passphrase",
+	"secret",
+	"privatekey",
+	"keypem",
+	"credential",
+	"accesskeyid",
+}
+
+// JSON keys, normalized as above, whose values are never logged
+var sensitiveKeys = map[string]bool{
+	"key":          true,
+	"apikey":       true,
+	"token":        true,
+	"sessiontoken": true,
+	"unsealkeys":   true,
+}
+
+// newLogContext returns ctx with the API logging subsystem.
+func newLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, logSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_CHAINLAUNCH", logSubsystem))
+}
+
+// logBodiesFor reports whether bodies of requests to the API path are logged.
+func logBodiesFor(path string) bool {
+	enabled := os.Getenv(logBodiesEnv)
+	if enabled == "" {
+		return false
+	}
+	area := apiArea(path)
+	for _, name := range strings.Split(enabled, ",") {
+		name = strings.TrimSpace(name)
+		if name == "all" || (name != "" && name == area) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiArea returns the first path segment below /api/v1, such as "nodes" for /api/v1/nodes/1/start.
+func apiArea(path string) string {
+	if i := strings.Index(path, "/api/v1/"); i >= 0 {
+		path = path[i+len("/api/v1/"):]
+	}
+	path = strings.TrimPrefix(path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+	return path
+}
+
+// isSensitiveKey reports whether the value of a JSON key must be redacted.
+func isSensitiveKey(key string) bool {
+	normalized := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+	if sensitiveKeys[normalized] || strings.HasSuffix(normalized, "token") {
+		return true
+	}
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactHeaders returns the headers as a log field, with sensitive values redacted.
+func redactHeaders(header http.Header) map[string]string {
+	fields := make(map[string]string, len(header))
+	for name, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			fields[name] = redactedValue
+			continue
+		}
+		fields[name] = strings.Join(values, ", ")
+	}
+	return fields
+}
+
+// redactBody returns a JSON body for logging with the values of sensitive keys
+// redacted at any depth. Bodies that are not JSON may hold secrets in a form
+// that cannot be recognised, so only their size is logged.
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return fmt.Sprintf("(non-JSON body, %d bytes)", len(body))
+	}
+	redacted, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return "(unloggable body)"
+	}
+	return string(redacted)
+}
+
+// redactValue replaces the values of sensitive keys in a decoded JSON value.
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveKey(key) && item != nil {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	case string:
+		// Private keys are recognisable whatever key holds them
+		if strings.Contains(v, "PRIVATE KEY-----") {
+			return redactedValue
+		}
+		// JSON documents embedded as strings, such as plugin or network configs
+		trimmed := strings.TrimSpace(v)
+		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			var nested interface{}
+			if json.Unmarshal([]byte(trimmed), &nested) == nil {
+				if redacted, err := json.Marshal(redactValue(nested)); err == nil {
+					return string(redacted)
+				}
+			}
+		}
+	}
+	return value
+}
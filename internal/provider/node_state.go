@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+// Values of the desired_state attribute of managed nodes
+const (
+	desiredStateRunning = "running"
+	desiredStateStopped = "stopped"
+)
+
+// nodeStateAttributes are the attributes that control whether a node runs
+// rather than how it is configured, so changing them alone does not update
+// the node configuration.
+var nodeStateAttributes = map[string]bool{
+	"desired_state":    true,
+	"restart_triggers": true,
+	"timeouts":         true,
+}
+
+// desiredStateAttribute returns the schema of the desired_state attribute.
+func desiredStateAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(desiredStateRunning),
+		Description: "Whether the node should be running or stopped: running (default) or stopped. " +
+			"The node is started or stopped to match, and a node whose live status differs shows up as drift.",
+	}
+}
+
+// restartTriggersAttribute returns the schema of the restart_triggers attribute.
+func restartTriggersAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		Description: "Arbitrary values that restart the node when they change, for example a hash of an environment file.",
+	}
+}
+
+// validateDesiredState checks the desired_state value of a configuration.
+func validateDesiredState(value types.String) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	switch value.ValueString() {
+	case desiredStateRunning, desiredStateStopped:
+		return nil
+	}
+	return fmt.Errorf("desired_state must be %s or %s, got %q", desiredStateRunning, desiredStateStopped, value.ValueString())
+}
+
+// observedDesiredState maps the live status of a node onto desired_state, so
+// that a node started or stopped outside Terraform shows up as drift. While
+// the node is on its way to running, the current value is kept.
+func observedDesiredState(status string, current types.String) types.String {
+	switch status {
+	case "RUNNING":
+		return types.StringValue(desiredStateRunning)
+	case "STOPPED", "STOPPING", "ERROR", "FAILED":
+		return types.StringValue(desiredStateStopped)
+	}
+	if current.IsNull() || current.IsUnknown() {
+		return types.StringValue(desiredStateRunning)
+	}
+	return current
+}
+
+// restartRequested reports whether restart_triggers changed between state and plan.
+func restartRequested(state, plan types.Map) bool {
+	if plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+	return !plan.Equal(state)
+}
+
+// nodeConfigChanged reports whether an update changes anything other than
+// the attributes in nodeStateAttributes. Values that are only unknown because
+// they are computed do not count as changes.
+func nodeConfigChanged(req resource.UpdateRequest) (bool, error) {
+	var plan, state, config map[string]tftypes.Value
+	if err := req.Plan.Raw.As(&plan); err != nil {
+		return false, err
+	}
+	if err := req.State.Raw.As(&state); err != nil {
+		return false, err
+	}
+	if err := req.Config.Raw.As(&config); err != nil {
+		return false, err
+	}
+
+	for name, planned := range plan {
+		if nodeStateAttributes[name] {
+			continue
+		}
+		if !planned.IsKnown() {
+			if configured, ok := config[name]; ok && !configured.IsNull() {
+				return true, nil
+			}
+			continue
+		}
+		if !planned.Equal(state[name]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nodeStartingStatuses are transitional statuses of a node that is on its way to RUNNING.
+var nodeStartingStatuses = map[string]bool{
+	"CREATING":   true,
+	"PENDING":    true,
+	"STARTING":   true,
+	"UPDATING":   true,
+	"RESTARTING": true,
+}
+
+// reconcileNodeState starts, stops or restarts a node to match desired_state
+// and returns the node once it has settled.
+func reconcileNodeState(ctx context.Context, client *Client, nodeID int64, desired string, restart bool) (*models.HTTPNodeResponse, error) {
+	got, err := client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(nodeID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read node status: %w", err)
+	}
+	status := got.Payload.Status
+
+	if desired == desiredStateStopped {
+		if status == "STOPPED" {
+			return got.Payload, nil
+		}
+		if _, err := client.API.Nodes.StopNode(nodes.NewStopNodeParamsWithContext(ctx).WithID(nodeID), nil); err != nil {
+			return nil, fmt.Errorf("unable to stop node: %w", err)
+		}
+		return waitForNodeStatus(ctx, client, nodeID, "STOPPED")
+	}
+
+	switch {
+	case status == "RUNNING" && !restart:
+		return got.Payload, nil
+	case status == "RUNNING":
+		if _, err := client.API.Nodes.RestartNode(nodes.NewRestartNodeParamsWithContext(ctx).WithID(nodeID), nil); err != nil {
+			return nil, fmt.Errorf("unable to restart node: %w", err)
+		}
+	case nodeStartingStatuses[status]:
+		// Already starting, for example after a configuration update
+	default:
+		if _, err := client.API.Nodes.StartNode(nodes.NewStartNodeParamsWithContext(ctx).WithID(nodeID), nil); err != nil {
+			return nil, fmt.Errorf("unable to start node: %w", err)
+		}
+	}
+	return waitForNodeStatus(ctx, client, nodeID, "RUNNING")
+}
+
+// waitForNodeStatus polls a node until it reports the wanted status or ctx expires.
+func waitForNodeStatus(ctx context.Context, client *Client, nodeID int64, want string) (*models.HTTPNodeResponse, error) {
+	for {
+		got, err := client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(nodeID), nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("timed out waiting for node to reach %s state: %w", want, ctx.Err())
+			}
+			return nil, fmt.Errorf("failed to check node status: %w", err)
+		}
+		node := got.Payload
+
+		if node.Status == want {
+			return node, nil
+		}
+		if node.Status == "ERROR" || node.Status == "FAILED" {
+			return nil, fmt.Errorf("node entered %s state: %s", node.Status, node.ErrorMessage)
+		}
+
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return nil, fmt.Errorf("timed out waiting for node to reach %s state (last status %q): %w", want, node.Status, err)
+		}
+	}
+}
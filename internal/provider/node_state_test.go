@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestObservedDesiredState(t *testing.T) {
+	tests := []struct {
+		status  string
+		current types.String
+		want    string
+	}{
+		{"RUNNING", types.StringValue(desiredStateStopped), desiredStateRunning},
+		{"STOPPED", types.StringValue(desiredStateRunning), desiredStateStopped},
+		{"ERROR", types.StringValue(desiredStateRunning), desiredStateStopped},
+		{"STARTING", types.StringValue(desiredStateStopped), desiredStateStopped},
+		{"STARTING", types.StringNull(), desiredStateRunning},
+	}
+	for _, test := range tests {
+		if got := observedDesiredState(test.status, test.current); got.ValueString() != test.want {
+			t.Errorf("observedDesiredState(%q, %s) = %s, want %s", test.status, test.current, got, test.want)
+		}
+	}
+}
+
+func TestRestartRequested(t *testing.T) {
+	triggers := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"config": types.StringValue(value)})
+	}
+
+	if restartRequested(triggers("a"), triggers("a")) {
+		t.Error("unchanged triggers should not restart")
+	}
+	if !restartRequested(triggers("a"), triggers("b")) {
+		t.Error("changed triggers should restart")
+	}
+	if !restartRequested(types.MapNull(types.StringType), triggers("a")) {
+		t.Error("added triggers should restart")
+	}
+	if restartRequested(triggers("a"), types.MapNull(types.StringType)) {
+		t.Error("removed triggers should not restart")
+	}
+}
+
+func TestValidateDesiredState(t *testing.T) {
+	for _, value := range []types.String{types.StringValue(desiredStateRunning), types.StringValue(desiredStateStopped), types.StringNull(), types.StringUnknown()} {
+		if err := validateDesiredState(value); err != nil {
+			t.Errorf("unexpected error for %s: %s", value, err)
+		}
+	}
+	if err := validateDesiredState(types.StringValue("paused")); err == nil {
+		t.Error("expected an error for paused")
+	}
+}
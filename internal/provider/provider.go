@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -28,6 +33,20 @@ type ChainlaunchProviderModel struct {
 	APIKey   types.String `tfsdk:"api_key"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+	Token    types.String `tfsdk:"token"`
+
+	AuthMethod types.String `tfsdk:"auth_method"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait   types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
 }
 
 func (p *ChainlaunchProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -38,7 +57,7 @@ func (p *ChainlaunchProvider) Metadata(ctx context.Context, req provider.Metadat
 func (p *ChainlaunchProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Interact with Chainlaunch to manage Hyperledger Fabric organizations, nodes, networks, and key providers. " +
-			"Supports authentication via API key or username/password.",
+			"Supports authentication via API key, bearer token or username/password.",
 		Attributes: map[string]schema.Attribute{
 			"url": schema.StringAttribute{
 				Description: "The Chainlaunch API URL. Can also be set via the CHAINLAUNCH_URL environment variable.",
@@ -51,16 +70,74 @@ func (p *ChainlaunchProvider) Schema(ctx context.Context, req provider.SchemaReq
 				Sensitive: true,
 			},
 			"username": schema.StringAttribute{
-				Description: "The Chainlaunch username for basic authentication. Can also be set via the CHAINLAUNCH_USERNAME environment variable. " +
+				Description: "The Chainlaunch username, sent as configured by auth_method. Can also be set via the CHAINLAUNCH_USERNAME environment variable. " +
 					"Use either api_key or username/password for authentication.",
 				Optional: true,
 			},
 			"password": schema.StringAttribute{
-				Description: "The Chainlaunch password for basic authentication. Can also be set via the CHAINLAUNCH_PASSWORD environment variable. " +
+				Description: "The Chainlaunch password, sent as configured by auth_method. Can also be set via the CHAINLAUNCH_PASSWORD environment variable. " +
 					"Required when username is provided.",
 				Optional:  true,
 				Sensitive: true,
 			},
+			"token": schema.StringAttribute{
+				Description: "A bearer token for authentication, sent in the Authorization header. Takes precedence over the other credentials. " +
+					"Can also be set via the CHAINLAUNCH_TOKEN environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"auth_method": schema.StringAttribute{
+				Description: "How username and password are sent: 'basic' sends them with every request, " +
+					"'session' logs in once through /auth/login, reuses the session cookie and logs in again when the session expires. " +
+					"Can also be set via the CHAINLAUNCH_AUTH_METHOD environment variable. Default: 'basic'.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificate(s) used to verify the Chainlaunch API server, in addition to the system trust store. " +
+					"Can also be set via the CHAINLAUNCH_CA_CERT_PEM environment variable. Conflicts with ca_cert_file.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file holding PEM encoded CA certificate(s) used to verify the Chainlaunch API server. " +
+					"Can also be set via the CHAINLAUNCH_CA_CERT_FILE environment variable. Conflicts with ca_cert_pem.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded client certificate for mutual TLS. Requires client_key_pem. " +
+					"Can also be set via the CHAINLAUNCH_CLIENT_CERT_PEM environment variable.",
+				Optional: true,
+			},
+			"client_key_pem": schema.StringAttribute{
+				Description: "PEM encoded private key of client_cert_pem. " +
+					"Can also be set via the CHAINLAUNCH_CLIENT_KEY_PEM environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the Chainlaunch API server certificate. Only use this for testing. " +
+					"Can also be set via the CHAINLAUNCH_INSECURE_SKIP_VERIFY environment variable. Default: false.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used for requests to the Chainlaunch API (e.g., 'http://proxy.example.com:3128'). " +
+					"Can also be set via the CHAINLAUNCH_PROXY_URL environment variable. " +
+					"When unset, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables apply.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried after a transient failure " +
+					"(HTTP 429, 502, 503, 504 or a connection error). Set to 0 to disable retries. Default: 4.",
+				Optional: true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum time to wait between retries, as a duration (e.g., '30s'). " +
+					"Also caps the wait requested by a Retry-After header. Default: '30s'.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for a single HTTP request to the Chainlaunch API, as a duration (e.g., '2m'). Default: '1m'.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -81,6 +158,8 @@ func (p *ChainlaunchProvider) Configure(ctx context.Context, req provider.Config
 	apiKey := os.Getenv("CHAINLAUNCH_API_KEY")
 	username := os.Getenv("CHAINLAUNCH_USERNAME")
 	password := os.Getenv("CHAINLAUNCH_PASSWORD")
+	token := os.Getenv("CHAINLAUNCH_TOKEN")
+	authMethod := os.Getenv("CHAINLAUNCH_AUTH_METHOD")
 
 	if !config.URL.IsNull() {
 		url = config.URL.ValueString()
@@ -98,6 +177,17 @@ func (p *ChainlaunchProvider) Configure(ctx context.Context, req provider.Config
 		password = config.Password.ValueString()
 	}
 
+	if !config.Token.IsNull() {
+		token = config.Token.ValueString()
+	}
+
+	if !config.AuthMethod.IsNull() {
+		authMethod = config.AuthMethod.ValueString()
+	}
+	if authMethod == "" {
+		authMethod = AuthMethodBasic
+	}
+
 	// Validate configuration
 
 	if url == "" {
@@ -109,17 +199,18 @@ func (p *ChainlaunchProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
-	// Check that either API key or username/password is provided
+	// Check that an API key, a token or username/password is provided
 	hasAPIKey := apiKey != ""
+	hasToken := token != ""
 	hasUsernamePassword := username != "" && password != ""
 
-	if !hasAPIKey && !hasUsernamePassword {
+	if !hasAPIKey && !hasToken && !hasUsernamePassword {
 		resp.Diagnostics.AddError(
 			"Missing Authentication Credentials",
 			"The provider requires authentication credentials. "+
-				"Provide either an API key (api_key) or username and password (username and password). "+
+				"Provide an API key (api_key), a bearer token (token) or username and password (username and password). "+
 				"These can be set in the configuration or use environment variables: "+
-				"CHAINLAUNCH_API_KEY or CHAINLAUNCH_USERNAME and CHAINLAUNCH_PASSWORD.",
+				"CHAINLAUNCH_API_KEY, CHAINLAUNCH_TOKEN or CHAINLAUNCH_USERNAME and CHAINLAUNCH_PASSWORD.",
 		)
 	}
 
@@ -130,12 +221,52 @@ func (p *ChainlaunchProvider) Configure(ctx context.Context, req provider.Config
 		)
 	}
 
+	if authMethod != AuthMethodBasic && authMethod != AuthMethodSession {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auth_method"),
+			"Invalid Authentication Method",
+			fmt.Sprintf("auth_method must be %q or %q, got %q.", AuthMethodBasic, AuthMethodSession, authMethod),
+		)
+	}
+
+	transportSettings := transportSettingsFromConfig(config, &resp.Diagnostics)
+
+	maxRetries := int64(DefaultMaxRetries)
+	if !config.MaxRetries.IsNull() {
+		maxRetries = config.MaxRetries.ValueInt64()
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Max Retries",
+				"max_retries must be zero or greater.",
+			)
+		}
+	}
+
+	retryMaxWait := parseProviderDuration(config.RetryMaxWait, "retry_max_wait", DefaultRetryMaxWait, &resp.Diagnostics)
+	requestTimeout := parseProviderDuration(config.RequestTimeout, "request_timeout", DefaultRequestTimeout, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create a new Chainlaunch client using the configuration values
 	client := NewClient(url, apiKey, username, password)
+	client.Token = token
+	client.AuthMethod = authMethod
+	if err := client.ConfigureTransport(transportSettings); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid TLS or Proxy Configuration",
+			fmt.Sprintf("The provider cannot configure the connection to the Chainlaunch API: %s", err),
+		)
+		return
+	}
+	client.MaxRetries = int(maxRetries)
+	client.RetryMaxWait = retryMaxWait
+	if client.RetryWaitMin > retryMaxWait {
+		client.RetryWaitMin = retryMaxWait
+	}
+	client.RequestTimeout = requestTimeout
 
 	// Make the Chainlaunch client available during DataSource and Resource
 	// type Configure methods.
@@ -143,21 +274,99 @@ func (p *ChainlaunchProvider) Configure(ctx context.Context, req provider.Config
 	resp.ResourceData = client
 }
 
+// transportSettingsFromConfig resolves the TLS and proxy settings from the
+// configuration, falling back to their CHAINLAUNCH_* environment variables.
+func transportSettingsFromConfig(config ChainlaunchProviderModel, diags *diag.Diagnostics) TransportSettings {
+	settings := TransportSettings{
+		CACertPEM:     stringFromConfigOrEnv(config.CACertPEM, "CHAINLAUNCH_CA_CERT_PEM"),
+		ClientCertPEM: stringFromConfigOrEnv(config.ClientCertPEM, "CHAINLAUNCH_CLIENT_CERT_PEM"),
+		ClientKeyPEM:  stringFromConfigOrEnv(config.ClientKeyPEM, "CHAINLAUNCH_CLIENT_KEY_PEM"),
+		ProxyURL:      stringFromConfigOrEnv(config.ProxyURL, "CHAINLAUNCH_PROXY_URL"),
+	}
+
+	caCertFile := stringFromConfigOrEnv(config.CACertFile, "CHAINLAUNCH_CA_CERT_FILE")
+	if caCertFile != "" {
+		if settings.CACertPEM != "" {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Conflicting CA Certificate Settings",
+				"Set only one of ca_cert_pem and ca_cert_file.",
+			)
+		} else if pem, err := os.ReadFile(caCertFile); err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate",
+				fmt.Sprintf("Unable to read CA certificate file %q: %s", caCertFile, err),
+			)
+		} else {
+			settings.CACertPEM = string(pem)
+		}
+	}
+
+	if !config.InsecureSkipVerify.IsNull() {
+		settings.InsecureSkipVerify = config.InsecureSkipVerify.ValueBool()
+	} else if v := os.Getenv("CHAINLAUNCH_INSECURE_SKIP_VERIFY"); v != "" {
+		insecure, err := strconv.ParseBool(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid Environment Variable",
+				fmt.Sprintf("CHAINLAUNCH_INSECURE_SKIP_VERIFY must be true or false, got %q.", v),
+			)
+		}
+		settings.InsecureSkipVerify = insecure
+	}
+
+	return settings
+}
+
+// stringFromConfigOrEnv returns the configured value, or the environment variable when unset.
+func stringFromConfigOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// parseProviderDuration parses an optional duration attribute, falling back to def when unset.
+func parseProviderDuration(value types.String, attribute string, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return def
+	}
+
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid Duration",
+			fmt.Sprintf("%s must be a positive duration such as '30s' or '2m', got %q.", attribute, value.ValueString()),
+		)
+		return def
+	}
+	return d
+}
+
 func (p *ChainlaunchProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewOrganizationResource,
+		NewFabricOrganizationImportResource,
+		NewFabricCertificateRevocationResource,
 		NewNodeResource,
 		NewNetworkResource,
 		NewKeyProviderResource,
 		NewKeyResource,
+		NewCertificateRenewalResource,
 		NewFabricPeerResource,
 		NewFabricOrdererResource,
 		NewFabricNetworkResource,
+		NewFabricNetworkImportResource,
 		NewFabricIdentityResource,
 		NewFabricAddNodeResource,
 		NewFabricJoinNodeResource,
 		NewFabricAnchorPeersResource,
+		NewFabricOrganizationCRLResource,
 		NewBesuNetworkResource,
+		NewBesuNetworkImportResource,
 		NewBesuNodeResource,
 		NewFabricChaincodeResource,
 		NewFabricChaincodeDefinitionResource,
@@ -175,6 +384,18 @@ func (p *ChainlaunchProvider) Resources(ctx context.Context) []func() resource.R
 		NewNotificationProviderResource,
 		NewPluginResource,
 		NewPluginDeploymentResource,
+		NewUserResource,
+		NewRoleBindingResource,
+		NewAPIKeyResource,
+		NewSettingsResource,
+		NewFabricConfigProposalResource,
+		NewFabricConfigProposalSignatureResource,
+		NewNetworkShareResource,
+		NewSharedNetworkAcceptanceResource,
+		NewKeyShareResource,
+		NewChaincodeProposalResource,
+		NewChaincodeProposalAcceptanceResource,
+		NewFabricChaincodeLifecycleResource,
 	}
 }
 
@@ -196,6 +417,12 @@ func (p *ChainlaunchProvider) DataSources(ctx context.Context) []func() datasour
 		NewExternalFabricOrderersDataSource,
 		NewExternalBesuNodesDataSource,
 		NewPluginDataSource,
+		NewUsersDataSource,
+		NewPermissionsDataSource,
+		NewAPIKeyLogsDataSource,
+		NewSharedNetworksDataSource,
+		NewSharedChaincodesDataSource,
+		NewSharedKeysDataSource,
 	}
 }
 
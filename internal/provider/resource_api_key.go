@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/api_keys"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &APIKeyResource{}
+var _ resource.ResourceWithValidateConfig = &APIKeyResource{}
+
+func NewAPIKeyResource() resource.Resource {
+	return &APIKeyResource{}
+}
+
+// APIKeyResource manages an API key of the user the provider authenticates as.
+type APIKeyResource struct {
+	client *Client
+}
+
+type APIKeyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Role             types.String `tfsdk:"role"`
+	Permissions      types.Set    `tfsdk:"permissions"`
+	ExpiresIn        types.String `tfsdk:"expires_in"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	Key              types.String `tfsdk:"key"`
+	KeyPrefix        types.String `tfsdk:"key_prefix"`
+	UserID           types.Int64  `tfsdk:"user_id"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	LastUsedAt       types.String `tfsdk:"last_used_at"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+}
+
+func (r *APIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *APIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API key for CI pipelines and plugins. The secret is only returned when the key is created, so it is kept in the Terraform state. " +
+			"Every change creates a new key and deactivates the old one; use create_before_destroy to rotate without downtime.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the API key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of what the key is used for.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role of the key: ADMIN, OPERATOR, VIEWER, CUSTOM or MCP.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Permission codes granted to the key (e.g., NETWORK_READ), for keys with the CUSTOM role. See chainlaunch_permissions.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIfConfigured(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_in": schema.StringAttribute{
+				Optional:    true,
+				Description: "How long the key is valid from its creation, such as 30d or 1y. Keys without it do not expire.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that rotate the key when they change, for example a timestamp from the time provider.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret of the API key, sent as a bearer token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_prefix": schema.StringAttribute{
+				Computed:    true,
+				Description: "The non-secret prefix of the key, shown in the Chainlaunch UI.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the user that owns the key.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the key expires.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the key was last used.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the key was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *APIKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateUserRole(data.Role); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "Invalid Role", err.Error())
+	}
+}
+
+func (r *APIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *APIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := createAPIKeyRequest{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+		Role:        models.AuthRole(data.Role.ValueString()),
+		ExpiresIn:   data.ExpiresIn.ValueString(),
+	}
+	if !data.Permissions.IsNull() && !data.Permissions.IsUnknown() {
+		resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &createReq.Permissions, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	body, err := r.client.DoRequest(ctx, "POST", "/api-keys", createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create API key, got error: %s", err))
+		return
+	}
+
+	var created models.AuthCreateAPIKeyResponse
+	if err := json.Unmarshal(body, &created); err != nil {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse API key response, got error: %s", err))
+		return
+	}
+	if created.APIKey == nil {
+		resp.Diagnostics.AddError("Client Error", "The API did not return the created API key.")
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(created.APIKey.ID, 10))
+	data.Key = types.StringValue(created.Key)
+	resp.Diagnostics.Append(r.setAPIKey(ctx, &data, created.APIKey)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.APIKeys.GetAPIKey(api_keys.NewGetAPIKeyParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API key, got error: %s", err))
+		return
+	}
+
+	// A deactivated key can no longer be used, so plan a new one
+	if !got.Payload.IsActive {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.setAPIKey(ctx, &data, got.Payload)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The API has no update endpoint for API keys; every attribute that can be
+	// configured forces a replacement, so there is nothing to send here.
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APIKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.APIKeys.DeactivateAPIKey(api_keys.NewDeactivateAPIKeyParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deactivate API key, got error: %s", err))
+		return
+	}
+}
+
+// setAPIKey copies the key returned by the API into data. The secret is only
+// returned on creation and is left untouched.
+func (r *APIKeyResource) setAPIKey(ctx context.Context, data *APIKeyResourceModel, key *models.AuthAPIKey) diag.Diagnostics {
+	data.Name = types.StringValue(key.Name)
+	data.Role = types.StringValue(string(key.Role))
+	data.KeyPrefix = stringValueOrNull(key.KeyPrefix)
+	data.UserID = types.Int64Value(key.UserID)
+	data.ExpiresAt = stringValueOrNull(key.ExpiresAt)
+	data.LastUsedAt = stringValueOrNull(key.LastUsedAt)
+	data.CreatedAt = stringValueOrNull(key.CreatedAt)
+	if key.Description != "" {
+		data.Description = types.StringValue(key.Description)
+	}
+
+	permissions := make([]string, 0, len(key.Permissions))
+	for _, permission := range key.Permissions {
+		permissions = append(permissions, string(permission))
+	}
+	var diags diag.Diagnostics
+	data.Permissions, diags = types.SetValueFrom(ctx, types.StringType, permissions)
+	return diags
+}
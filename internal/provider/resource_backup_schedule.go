@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/backup_schedules"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -135,36 +137,31 @@ func (r *BackupScheduleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	createReq := CreateBackupScheduleRequest{
-		Name:           data.Name.ValueString(),
+	createReq := &models.HTTPCreateBackupScheduleRequest{
+		Name:           data.Name.ValueStringPointer(),
 		Description:    data.Description.ValueString(),
-		TargetID:       int(data.TargetID.ValueInt64()),
-		CronExpression: data.CronExpression.ValueString(),
+		TargetID:       data.TargetID.ValueInt64Pointer(),
+		CronExpression: data.CronExpression.ValueStringPointer(),
 		Enabled:        data.Enabled.ValueBool(),
-		RetentionDays:  int(data.RetentionDays.ValueInt64()),
+		RetentionDays:  data.RetentionDays.ValueInt64Pointer(),
 	}
 
-	body, err := r.client.DoRequest("POST", "/backups/schedules", createReq)
+	created, err := r.client.API.BackupSchedules.CreateBackupSchedule(backup_schedules.NewCreateBackupScheduleParamsWithContext(ctx).WithRequest(createReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup schedule, got error: %s", err))
 		return
 	}
-
-	var schedule BackupSchedule
-	if err := json.Unmarshal(body, &schedule); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse backup schedule response, got error: %s\nResponse body: %s", err, string(body)))
-		return
-	}
+	schedule := created.Payload
 
 	data.ID = types.StringValue(fmt.Sprintf("%d", schedule.ID))
 	data.Name = types.StringValue(schedule.Name)
 	if schedule.Description != "" {
 		data.Description = types.StringValue(schedule.Description)
 	}
-	data.TargetID = types.Int64Value(int64(schedule.TargetID))
+	data.TargetID = types.Int64Value(schedule.TargetID)
 	data.CronExpression = types.StringValue(schedule.CronExpression)
 	data.Enabled = types.BoolValue(schedule.Enabled)
-	data.RetentionDays = types.Int64Value(int64(schedule.RetentionDays))
+	data.RetentionDays = types.Int64Value(schedule.RetentionDays)
 	if schedule.LastRunAt != "" {
 		data.LastRunAt = types.StringValue(schedule.LastRunAt)
 	}
@@ -190,26 +187,31 @@ func (r *BackupScheduleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/backups/schedules/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup schedule, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var schedule BackupSchedule
-	if err := json.Unmarshal(body, &schedule); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse backup schedule response, got error: %s", err))
+	got, err := r.client.API.BackupSchedules.GetBackupSchedule(backup_schedules.NewGetBackupScheduleParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup schedule, got error: %s", err))
 		return
 	}
+	schedule := got.Payload
 
 	data.Name = types.StringValue(schedule.Name)
 	if schedule.Description != "" {
 		data.Description = types.StringValue(schedule.Description)
 	}
-	data.TargetID = types.Int64Value(int64(schedule.TargetID))
+	data.TargetID = types.Int64Value(schedule.TargetID)
 	data.CronExpression = types.StringValue(schedule.CronExpression)
 	data.Enabled = types.BoolValue(schedule.Enabled)
-	data.RetentionDays = types.Int64Value(int64(schedule.RetentionDays))
+	data.RetentionDays = types.Int64Value(schedule.RetentionDays)
 	if schedule.LastRunAt != "" {
 		data.LastRunAt = types.StringValue(schedule.LastRunAt)
 	}
@@ -245,35 +247,36 @@ func (r *BackupScheduleResource) Update(ctx context.Context, req resource.Update
 	// Preserve created_at from state
 	data.CreatedAt = state.CreatedAt
 
-	updateReq := CreateBackupScheduleRequest{
-		Name:           data.Name.ValueString(),
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	updateReq := &models.HTTPUpdateBackupScheduleRequest{
+		Name:           data.Name.ValueStringPointer(),
 		Description:    data.Description.ValueString(),
-		TargetID:       int(data.TargetID.ValueInt64()),
-		CronExpression: data.CronExpression.ValueString(),
+		TargetID:       data.TargetID.ValueInt64Pointer(),
+		CronExpression: data.CronExpression.ValueStringPointer(),
 		Enabled:        data.Enabled.ValueBool(),
-		RetentionDays:  int(data.RetentionDays.ValueInt64()),
+		RetentionDays:  data.RetentionDays.ValueInt64Pointer(),
 	}
 
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/backups/schedules/%s", data.ID.ValueString()), updateReq)
+	updated, err := r.client.API.BackupSchedules.UpdateBackupSchedule(backup_schedules.NewUpdateBackupScheduleParamsWithContext(ctx).WithID(id).WithRequest(updateReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update backup schedule, got error: %s", err))
 		return
 	}
-
-	var schedule BackupSchedule
-	if err := json.Unmarshal(body, &schedule); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse backup schedule response, got error: %s", err))
-		return
-	}
+	schedule := updated.Payload
 
 	data.Name = types.StringValue(schedule.Name)
 	if schedule.Description != "" {
 		data.Description = types.StringValue(schedule.Description)
 	}
-	data.TargetID = types.Int64Value(int64(schedule.TargetID))
+	data.TargetID = types.Int64Value(schedule.TargetID)
 	data.CronExpression = types.StringValue(schedule.CronExpression)
 	data.Enabled = types.BoolValue(schedule.Enabled)
-	data.RetentionDays = types.Int64Value(int64(schedule.RetentionDays))
+	data.RetentionDays = types.Int64Value(schedule.RetentionDays)
 	if schedule.LastRunAt != "" {
 		data.LastRunAt = types.StringValue(schedule.LastRunAt)
 	}
@@ -296,7 +299,13 @@ func (r *BackupScheduleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/backups/schedules/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.BackupSchedules.DeleteBackupSchedule(backup_schedules.NewDeleteBackupScheduleParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup schedule, got error: %s", err))
 		return
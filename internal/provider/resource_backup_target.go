@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -12,6 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/backup_targets"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -148,30 +150,25 @@ func (r *BackupTargetResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	createReq := CreateBackupTargetRequest{
-		Name:            data.Name.ValueString(),
-		Type:            data.Type.ValueString(),
-		Endpoint:        data.Endpoint.ValueString(),
-		Region:          data.Region.ValueString(),
-		AccessKeyID:     data.AccessKeyID.ValueString(),
-		SecretAccessKey: data.SecretAccessKey.ValueString(),
-		BucketName:      data.BucketName.ValueString(),
-		BucketPath:      data.BucketPath.ValueString(),
-		ForcePathStyle:  data.ForcePathStyle.ValueBool(),
-		ResticPassword:  data.ResticPassword.ValueString(),
+	createReq := &models.HTTPCreateBackupTargetRequest{
+		Name:           data.Name.ValueStringPointer(),
+		Type:           data.Type.ValueStringPointer(),
+		Endpoint:       data.Endpoint.ValueString(),
+		Region:         data.Region.ValueString(),
+		AccessKeyID:    data.AccessKeyID.ValueString(),
+		SecretKey:      data.SecretAccessKey.ValueString(),
+		BucketName:     data.BucketName.ValueString(),
+		BucketPath:     data.BucketPath.ValueString(),
+		ForcePathStyle: data.ForcePathStyle.ValueBool(),
+		ResticPassword: data.ResticPassword.ValueString(),
 	}
 
-	body, err := r.client.DoRequest("POST", "/backups/targets", createReq)
+	created, err := r.client.API.BackupTargets.CreateBackupTarget(backup_targets.NewCreateBackupTargetParamsWithContext(ctx).WithRequest(createReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create backup target, got error: %s", err))
 		return
 	}
-
-	var target BackupTarget
-	if err := json.Unmarshal(body, &target); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse backup target response, got error: %s\nResponse body: %s", err, string(body)))
-		return
-	}
+	target := created.Payload
 
 	data.ID = types.StringValue(fmt.Sprintf("%d", target.ID))
 	data.Name = types.StringValue(target.Name)
@@ -204,17 +201,22 @@ func (r *BackupTargetResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/backups/targets/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup target, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var target BackupTarget
-	if err := json.Unmarshal(body, &target); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse backup target response, got error: %s", err))
+	got, err := r.client.API.BackupTargets.GetBackupTarget(backup_targets.NewGetBackupTargetParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup target, got error: %s", err))
 		return
 	}
+	target := got.Payload
 
 	data.Name = types.StringValue(target.Name)
 	data.Type = types.StringValue(target.Type)
@@ -256,30 +258,31 @@ func (r *BackupTargetResource) Update(ctx context.Context, req resource.UpdateRe
 	// Preserve created_at from state
 	data.CreatedAt = state.CreatedAt
 
-	updateReq := CreateBackupTargetRequest{
-		Name:            data.Name.ValueString(),
-		Type:            data.Type.ValueString(),
-		Endpoint:        data.Endpoint.ValueString(),
-		Region:          data.Region.ValueString(),
-		AccessKeyID:     data.AccessKeyID.ValueString(),
-		SecretAccessKey: data.SecretAccessKey.ValueString(),
-		BucketName:      data.BucketName.ValueString(),
-		BucketPath:      data.BucketPath.ValueString(),
-		ForcePathStyle:  data.ForcePathStyle.ValueBool(),
-		ResticPassword:  data.ResticPassword.ValueString(),
-	}
-
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/backups/targets/%s", data.ID.ValueString()), updateReq)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update backup target, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var target BackupTarget
-	if err := json.Unmarshal(body, &target); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse backup target response, got error: %s", err))
+	updateReq := &models.HTTPUpdateBackupTargetRequest{
+		Name:           data.Name.ValueStringPointer(),
+		Type:           data.Type.ValueStringPointer(),
+		Endpoint:       data.Endpoint.ValueString(),
+		Region:         data.Region.ValueString(),
+		AccessKeyID:    data.AccessKeyID.ValueString(),
+		SecretKey:      data.SecretAccessKey.ValueString(),
+		BucketName:     data.BucketName.ValueString(),
+		BucketPath:     data.BucketPath.ValueString(),
+		ForcePathStyle: data.ForcePathStyle.ValueBool(),
+		ResticPassword: data.ResticPassword.ValueString(),
+	}
+
+	updated, err := r.client.API.BackupTargets.UpdateBackupTarget(backup_targets.NewUpdateBackupTargetParamsWithContext(ctx).WithID(id).WithRequest(updateReq), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update backup target, got error: %s", err))
 		return
 	}
+	target := updated.Payload
 
 	data.Name = types.StringValue(target.Name)
 	data.Type = types.StringValue(target.Type)
@@ -308,7 +311,13 @@ func (r *BackupTargetResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/backups/targets/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.BackupTargets.DeleteBackupTarget(backup_targets.NewDeleteBackupTargetParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup target, got error: %s", err))
 		return
@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 func TestAccBackupTargetResource(t *testing.T) {
@@ -72,20 +74,20 @@ resource "chainlaunch_backup_target" "test" {
 func TestBackupTargetResourceWithMockServer(t *testing.T) {
 	// Create a mock storage for backup targets
 	storage := &mockBackupTargetStorage{
-		targets: make(map[int]BackupTarget),
+		targets: make(map[int]models.HTTPBackupTargetResponse),
 		nextID:  1,
 	}
 
 	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
-		case r.Method == "POST" && r.URL.Path == "/backups/targets":
+		case r.Method == "POST" && r.URL.Path == "/api/v1/backups/targets":
 			storage.handleCreate(w, r)
-		case r.Method == "GET" && r.URL.Path == "/backups/targets/1":
+		case r.Method == "GET" && r.URL.Path == "/api/v1/backups/targets/1":
 			storage.handleGet(w, r, 1)
-		case r.Method == "PUT" && r.URL.Path == "/backups/targets/1":
+		case r.Method == "PUT" && r.URL.Path == "/api/v1/backups/targets/1":
 			storage.handleUpdate(w, r, 1)
-		case r.Method == "DELETE" && r.URL.Path == "/backups/targets/1":
+		case r.Method == "DELETE" && r.URL.Path == "/api/v1/backups/targets/1":
 			storage.handleDelete(w, r, 1)
 		default:
 			w.WriteHeader(http.StatusNotFound)
@@ -160,12 +162,12 @@ func testAccCheckBackupTargetExists(resourceName string, storage *mockBackupTarg
 // Mock storage for backup targets
 type mockBackupTargetStorage struct {
 	mu      sync.Mutex
-	targets map[int]BackupTarget
+	targets map[int]models.HTTPBackupTargetResponse
 	nextID  int
 }
 
 func (s *mockBackupTargetStorage) handleCreate(w http.ResponseWriter, r *http.Request) {
-	var req CreateBackupTargetRequest
+	var req models.HTTPCreateBackupTargetRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -174,10 +176,10 @@ func (s *mockBackupTargetStorage) handleCreate(w http.ResponseWriter, r *http.Re
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	target := BackupTarget{
-		ID:             s.nextID,
-		Name:           req.Name,
-		Type:           req.Type,
+	target := models.HTTPBackupTargetResponse{
+		ID:             int64(s.nextID),
+		Name:           *req.Name,
+		Type:           *req.Type,
 		Endpoint:       req.Endpoint,
 		Region:         req.Region,
 		AccessKeyID:    req.AccessKeyID,
@@ -211,7 +213,7 @@ func (s *mockBackupTargetStorage) handleGet(w http.ResponseWriter, r *http.Reque
 }
 
 func (s *mockBackupTargetStorage) handleUpdate(w http.ResponseWriter, r *http.Request, id int) {
-	var req CreateBackupTargetRequest
+	var req models.HTTPUpdateBackupTargetRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -226,8 +228,8 @@ func (s *mockBackupTargetStorage) handleUpdate(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	target.Name = req.Name
-	target.Type = req.Type
+	target.Name = *req.Name
+	target.Type = *req.Type
 	target.Endpoint = req.Endpoint
 	target.Region = req.Region
 	target.AccessKeyID = req.AccessKeyID
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/besu_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &BesuNetworkResource{}
@@ -248,83 +250,34 @@ func (r *BesuNetworkResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	// Build the config object
-	config := map[string]interface{}{
-		"chainId":                 data.ChainID.ValueInt64(),
-		"consensus":               data.Consensus.ValueString(),
-		"blockPeriod":             data.BlockPeriod.ValueInt64(),
-		"epochLength":             data.EpochLength.ValueInt64(),
-		"requestTimeout":          data.RequestTimeout.ValueInt64(),
-		"initialValidatorsKeyIds": keyIds, // Note: "Validators" is plural in API
-	}
-
-	// Add genesis fields with defaults if not provided
-	if !data.GasLimit.IsNull() {
-		config["gasLimit"] = data.GasLimit.ValueString()
-	} else {
-		config["gasLimit"] = "0x1fffffffffffff" // Default gas limit
-	}
-
-	if !data.Difficulty.IsNull() {
-		config["difficulty"] = data.Difficulty.ValueString()
-	} else {
-		config["difficulty"] = "0x1" // Default difficulty for private networks
-	}
-
-	if !data.MixHash.IsNull() {
-		config["mixHash"] = data.MixHash.ValueString()
-	} else {
-		config["mixHash"] = "0x0000000000000000000000000000000000000000000000000000000000000000" // Default mix hash
-	}
-
-	if !data.Nonce.IsNull() {
-		config["nonce"] = data.Nonce.ValueString()
-	} else {
-		config["nonce"] = "0x0000000000000000" // Default nonce (8 bytes = 16 hex chars)
-	}
-
-	if !data.Timestamp.IsNull() {
-		config["timestamp"] = data.Timestamp.ValueString()
-	} else {
-		config["timestamp"] = "0x0" // Default timestamp (genesis)
-	}
-
-	if !data.Coinbase.IsNull() {
-		config["coinbase"] = data.Coinbase.ValueString()
-	} else {
-		config["coinbase"] = "0x0000000000000000000000000000000000000000" // Default coinbase
-	}
-
-	// Build the request body
-	createReq := map[string]interface{}{
-		"name":   data.Name.ValueString(),
-		"config": config,
+	// Build the config object, filling in genesis defaults that are not provided
+	config := &models.HTTPCreateBesuNetworkRequestConfig{
+		ChainID:                 data.ChainID.ValueInt64Pointer(),
+		Consensus:               data.Consensus.ValueStringPointer(),
+		BlockPeriod:             data.BlockPeriod.ValueInt64Pointer(),
+		EpochLength:             data.EpochLength.ValueInt64Pointer(),
+		RequestTimeout:          data.RequestTimeout.ValueInt64Pointer(),
+		InitialValidatorsKeyIds: keyIds,
+		GasLimit:                stringOrDefault(data.GasLimit, "0x1fffffffffffff"),                                                  // Default gas limit
+		Difficulty:              stringOrDefault(data.Difficulty, "0x1"),                                                             // Default difficulty for private networks
+		MixHash:                 stringOrDefault(data.MixHash, "0x0000000000000000000000000000000000000000000000000000000000000000"), // Default mix hash
+		Nonce:                   stringOrDefault(data.Nonce, "0x0000000000000000"),                                                   // Default nonce (8 bytes = 16 hex chars)
+		Timestamp:               stringOrDefault(data.Timestamp, "0x0"),                                                              // Default timestamp (genesis)
+		Coinbase:                stringOrDefault(data.Coinbase, "0x0000000000000000000000000000000000000000"),                        // Default coinbase
 	}
 
-	if !data.Description.IsNull() {
-		createReq["description"] = data.Description.ValueString()
+	createReq := &models.HTTPCreateBesuNetworkRequest{
+		Name:        data.Name.ValueStringPointer(),
+		Description: data.Description.ValueString(),
+		Config:      config,
 	}
 
-	body, err := r.client.DoRequest("POST", "/networks/besu", createReq)
+	created, err := r.client.API.BesuNetworks.CreateBesuNetwork(besu_networks.NewCreateBesuNetworkParamsWithContext(ctx).WithRequest(createReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Besu network, got error: %s", err))
 		return
 	}
-
-	var networkResp struct {
-		ID          int64  `json:"id"`
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		Platform    string `json:"platform"`
-		Status      string `json:"status"`
-		CreatedAt   string `json:"createdAt"`
-		UpdatedAt   string `json:"updatedAt"`
-	}
-
-	if err := json.Unmarshal(body, &networkResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu network response, got error: %s", err))
-		return
-	}
+	networkResp := created.Payload
 
 	// Set the response values
 	data.ID = types.StringValue(fmt.Sprintf("%d", networkResp.ID))
@@ -348,28 +301,22 @@ func (r *BesuNetworkResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/besu/%s", data.ID.ValueString())
-
-	body, err := r.client.DoRequest("GET", endpoint, nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Besu network, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var networkResp struct {
-		ID          int64  `json:"id"`
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		Platform    string `json:"platform"`
-		Status      string `json:"status"`
-		CreatedAt   string `json:"createdAt"`
-		UpdatedAt   string `json:"updatedAt"`
-	}
-
-	if err := json.Unmarshal(body, &networkResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu network response, got error: %s", err))
+	got, err := r.client.API.BesuNetworks.GetBesuNetwork(besu_networks.NewGetBesuNetworkParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Besu network, got error: %s", err))
 		return
 	}
+	networkResp := got.Payload
 
 	// Update state with API response
 	// Note: Name is preserved from state as API might not return it
@@ -421,9 +368,13 @@ func (r *BesuNetworkResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/besu/%s", data.ID.ValueString())
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
 
-	_, err := r.client.DoRequest("DELETE", endpoint, nil)
+	_, err = r.client.API.BesuNetworks.DeleteBesuNetwork(besu_networks.NewDeleteBesuNetworkParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Besu network, got error: %s", err))
 		return
@@ -439,3 +390,12 @@ func (r *BesuNetworkResource) ImportState(ctx context.Context, req resource.Impo
 	emptyList, _ := types.ListValue(types.Int64Type, []attr.Value{})
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("initial_validator_key_ids"), emptyList)...)
 }
+
+// stringOrDefault returns the value of an optional string attribute, or def
+// when it is not set.
+func stringOrDefault(v types.String, def string) string {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	return v.ValueString()
+}
@@ -0,0 +1,316 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/besu_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &BesuNetworkImportResource{}
+var _ resource.ResourceWithValidateConfig = &BesuNetworkImportResource{}
+
+func NewBesuNetworkImportResource() resource.Resource {
+	return &BesuNetworkImportResource{}
+}
+
+// BesuNetworkImportResource registers a Besu network that was bootstrapped
+// outside Chainlaunch from its genesis file.
+type BesuNetworkImportResource struct {
+	client *Client
+}
+
+type BesuNetworkImportResourceModel struct {
+	ID                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	ChainID            types.Int64  `tfsdk:"chain_id"`
+	GenesisJSON        types.String `tfsdk:"genesis_json"`
+	GenesisPath        types.String `tfsdk:"genesis_path"`
+	Consensus          types.String `tfsdk:"consensus"`
+	ValidatorAddresses types.List   `tfsdk:"validator_addresses"`
+	Status             types.String `tfsdk:"status"`
+	Platform           types.String `tfsdk:"platform"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+func (r *BesuNetworkImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_besu_network_import"
+}
+
+func (r *BesuNetworkImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports an existing Hyperledger Besu network from its genesis file, so that chainlaunch_besu_node can run nodes in it. " +
+			"The genesis is validated before it is sent: it must name a QBFT, IBFT 2.0 or clique consensus, list its validators in extraData and use chain_id.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the imported network, usable as network_id of chainlaunch_besu_node.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the network in Chainlaunch.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"chain_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The chain ID of the network. Must match config.chainId of the genesis.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"genesis_json": schema.StringAttribute{
+				Optional:    true,
+				Description: "The genesis file content. Conflicts with genesis_path.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"genesis_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the genesis file. Conflicts with genesis_json.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"consensus": schema.StringAttribute{
+				Computed:    true,
+				Description: "The consensus named in the genesis config (qbft, ibft2 or clique).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"validator_addresses": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Addresses of the initial validators decoded from the genesis extraData.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the network.",
+			},
+			"platform": schema.StringAttribute{
+				Computed:    true,
+				Description: "The platform of the network (besu).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the network was imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BesuNetworkImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data BesuNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.GenesisJSON.IsUnknown() || data.GenesisPath.IsUnknown() {
+		return
+	}
+
+	if data.GenesisJSON.IsNull() == data.GenesisPath.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Genesis Source",
+			"Exactly one of genesis_json and genesis_path must be set.",
+		)
+		return
+	}
+
+	if data.ChainID.IsUnknown() {
+		return
+	}
+	if _, _, err := loadBesuGenesis(data); err != nil {
+		resp.Diagnostics.AddError("Invalid Genesis", err.Error())
+	}
+}
+
+// loadBesuGenesis reads the configured genesis and checks it against chain_id.
+func loadBesuGenesis(data BesuNetworkImportResourceModel) ([]byte, *BesuGenesis, error) {
+	raw := []byte(data.GenesisJSON.ValueString())
+	if !data.GenesisPath.IsNull() {
+		var err error
+		raw, err = os.ReadFile(data.GenesisPath.ValueString())
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to read genesis file: %w", err)
+		}
+	}
+
+	genesis, err := ParseBesuGenesis(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	if genesis.ChainID != data.ChainID.ValueInt64() {
+		return nil, nil, fmt.Errorf("chain_id %d does not match chainId %d of the genesis", data.ChainID.ValueInt64(), genesis.ChainID)
+	}
+	return raw, genesis, nil
+}
+
+func (r *BesuNetworkImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BesuNetworkImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BesuNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	raw, genesis, err := loadBesuGenesis(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Genesis", err.Error())
+		return
+	}
+
+	// The API takes the genesis file as bytes, which JSON encodes as base64
+	genesisFile := base64.StdEncoding.EncodeToString(raw)
+	params := besu_networks.NewImportBesuNetworkParamsWithContext(ctx).
+		WithRequest(&models.HTTPImportBesuNetworkRequest{
+			Name:        data.Name.ValueStringPointer(),
+			Description: data.Description.ValueString(),
+			ChainID:     data.ChainID.ValueInt64Pointer(),
+			GenesisFile: &genesisFile,
+		})
+	imported, err := r.client.API.BesuNetworks.ImportBesuNetwork(params, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import Besu network, got error: %s", err))
+		return
+	}
+
+	validators, diags := types.ListValueFrom(ctx, types.StringType, genesis.Validators)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d", imported.Payload.NetworkID))
+	data.Consensus = types.StringValue(genesis.Consensus)
+	data.ValidatorAddresses = validators
+
+	got, err := r.client.API.BesuNetworks.GetBesuNetwork(besu_networks.NewGetBesuNetworkParamsWithContext(ctx).WithID(imported.Payload.NetworkID), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read imported Besu network, got error: %s", err))
+		return
+	}
+	data.Status = stringValueOrNull(got.Payload.Status)
+	data.Platform = stringValueOrNull(got.Payload.Platform)
+	data.CreatedAt = stringValueOrNull(got.Payload.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BesuNetworkImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BesuNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.BesuNetworks.GetBesuNetwork(besu_networks.NewGetBesuNetworkParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Besu network, got error: %s", err))
+		return
+	}
+
+	data.Status = stringValueOrNull(got.Payload.Status)
+	data.Platform = stringValueOrNull(got.Payload.Platform)
+	data.CreatedAt = stringValueOrNull(got.Payload.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BesuNetworkImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The API has no update endpoint for Besu networks; every attribute that can be
+	// configured forces a replacement, so there is nothing to send here.
+	var data BesuNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BesuNetworkImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BesuNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.BesuNetworks.DeleteBesuNetwork(besu_networks.NewDeleteBesuNetworkParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Besu network, got error: %s", err))
+		return
+	}
+}
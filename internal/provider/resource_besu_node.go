@@ -5,19 +5,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &BesuNodeResource{}
 var _ resource.ResourceWithImportState = &BesuNodeResource{}
+var _ resource.ResourceWithValidateConfig = &BesuNodeResource{}
 
 func NewBesuNodeResource() resource.Resource {
 	return &BesuNodeResource{}
@@ -58,41 +62,14 @@ type BesuNodeResourceModel struct {
 	JWTPublicKeyContent        types.String `tfsdk:"jwt_public_key_content"`
 	// Environment variables
 	Environment types.Map `tfsdk:"environment"`
+	// Lifecycle
+	DesiredState    types.String `tfsdk:"desired_state"`
+	RestartTriggers types.Map    `tfsdk:"restart_triggers"`
 	// Computed
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
-}
-
-// BesuNodeResponse represents the API response for a Besu node
-type BesuNodeResponse struct {
-	ID                         int64             `json:"id"`
-	Name                       string            `json:"name"`
-	NetworkID                  int64             `json:"networkId"`
-	KeyID                      int64             `json:"keyId"`
-	Mode                       string            `json:"mode"`
-	Version                    string            `json:"version"`
-	ExternalIP                 string            `json:"externalIp"`
-	InternalIP                 string            `json:"internalIp"`
-	P2PHost                    string            `json:"p2pHost"`
-	P2PPort                    int64             `json:"p2pPort"`
-	RPCHost                    string            `json:"rpcHost"`
-	RPCPort                    int64             `json:"rpcPort"`
-	BootNodes                  []string          `json:"bootNodes"`
-	MinGasPrice                int64             `json:"minGasPrice"`
-	AccountsAllowList          []string          `json:"accountsAllowList"`
-	NodesAllowList             []string          `json:"nodesAllowList"`
-	HostAllowList              string            `json:"hostAllowList"`
-	MetricsEnabled             bool              `json:"metricsEnabled"`
-	MetricsPort                int64             `json:"metricsPort"`
-	MetricsProtocol            string            `json:"metricsProtocol"`
-	JWTEnabled                 bool              `json:"jwtEnabled"`
-	JWTAuthenticationAlgorithm string            `json:"jwtAuthenticationAlgorithm"`
-	JWTPublicKeyContent        string            `json:"jwtPublicKeyContent"`
-	Environment                map[string]string `json:"env"`
-	Status                     string            `json:"status"`
-	CreatedAt                  string            `json:"createdAt"`
-	UpdatedAt                  string            `json:"updatedAt"`
+	Status    types.String   `tfsdk:"status"`
+	CreatedAt types.String   `tfsdk:"created_at"`
+	UpdatedAt types.String   `tfsdk:"updated_at"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *BesuNodeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -208,6 +185,8 @@ func (r *BesuNodeResource) Schema(ctx context.Context, req resource.SchemaReques
 				Optional:    true,
 				Description: "Environment variables for the Besu node.",
 			},
+			"desired_state":    desiredStateAttribute(),
+			"restart_triggers": restartTriggersAttribute(),
 			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "Current status of the node (RUNNING, CREATING, ERROR, etc.).",
@@ -221,6 +200,22 @@ func (r *BesuNodeResource) Schema(ctx context.Context, req resource.SchemaReques
 				Description: "The timestamp when the node was last updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *BesuNodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var desiredState types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("desired_state"), &desiredState)...)
+	if err := validateDesiredState(desiredState); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("desired_state"), "Invalid Desired State", err.Error())
 	}
 }
 
@@ -249,98 +244,71 @@ func (r *BesuNodeResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Build the besuNode config object (as per API schema)
-	besuNodeConfig := map[string]interface{}{
-		"type":       "besu",
-		"networkId":  data.NetworkID.ValueInt64(),
-		"keyId":      data.KeyID.ValueInt64(),
-		"mode":       data.Mode.ValueString(),
-		"externalIp": data.ExternalIP.ValueString(),
-		"internalIp": data.InternalIP.ValueString(),
-		"p2pHost":    data.P2PHost.ValueString(),
-		"p2pPort":    data.P2PPort.ValueInt64(),
-		"rpcHost":    data.RPCHost.ValueString(),
-		"rpcPort":    data.RPCPort.ValueInt64(),
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !data.Version.IsNull() {
-		besuNodeConfig["version"] = data.Version.ValueString()
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the besuNode config object (as per API schema)
+	besuNodeConfig := &models.TypesBesuNodeConfig{
+		Type:                       "besu",
+		NetworkID:                  data.NetworkID.ValueInt64Pointer(),
+		KeyID:                      data.KeyID.ValueInt64Pointer(),
+		Mode:                       data.Mode.ValueString(),
+		ExternalIP:                 data.ExternalIP.ValueStringPointer(),
+		InternalIP:                 data.InternalIP.ValueStringPointer(),
+		P2pHost:                    data.P2PHost.ValueStringPointer(),
+		P2pPort:                    data.P2PPort.ValueInt64Pointer(),
+		RPCHost:                    data.RPCHost.ValueStringPointer(),
+		RPCPort:                    data.RPCPort.ValueInt64Pointer(),
+		Version:                    data.Version.ValueString(),
+		MinGasPrice:                data.MinGasPrice.ValueInt64(),
+		HostAllowList:              data.HostAllowList.ValueString(),
+		MetricsEnabled:             data.MetricsEnabled.ValueBool(),
+		MetricsPort:                data.MetricsPort.ValueInt64(),
+		MetricsProtocol:            data.MetricsProtocol.ValueString(),
+		JwtEnabled:                 data.JWTEnabled.ValueBool(),
+		JwtAuthenticationAlgorithm: data.JWTAuthenticationAlgorithm.ValueString(),
+		JwtPublicKeyContent:        data.JWTPublicKeyContent.ValueString(),
 	}
 
 	// Optional fields
 	if !data.BootNodes.IsNull() {
-		var bootNodes []string
-		data.BootNodes.ElementsAs(ctx, &bootNodes, false)
-		besuNodeConfig["bootNodes"] = bootNodes
+		resp.Diagnostics.Append(data.BootNodes.ElementsAs(ctx, &besuNodeConfig.BootNodes, false)...)
 	}
-
-	if !data.MinGasPrice.IsNull() {
-		besuNodeConfig["minGasPrice"] = data.MinGasPrice.ValueInt64()
-	}
-
 	if !data.AccountsAllowList.IsNull() {
-		var accountsAllowList []string
-		data.AccountsAllowList.ElementsAs(ctx, &accountsAllowList, false)
-		besuNodeConfig["accountsAllowList"] = accountsAllowList
+		resp.Diagnostics.Append(data.AccountsAllowList.ElementsAs(ctx, &besuNodeConfig.AccountsAllowList, false)...)
 	}
-
 	if !data.NodesAllowList.IsNull() {
-		var nodesAllowList []string
-		data.NodesAllowList.ElementsAs(ctx, &nodesAllowList, false)
-		besuNodeConfig["nodesAllowList"] = nodesAllowList
-	}
-
-	if !data.HostAllowList.IsNull() {
-		besuNodeConfig["hostAllowList"] = data.HostAllowList.ValueString()
-	}
-
-	if !data.MetricsEnabled.IsNull() {
-		besuNodeConfig["metricsEnabled"] = data.MetricsEnabled.ValueBool()
-	}
-
-	if !data.MetricsPort.IsNull() {
-		besuNodeConfig["metricsPort"] = data.MetricsPort.ValueInt64()
-	}
-
-	if !data.MetricsProtocol.IsNull() {
-		besuNodeConfig["metricsProtocol"] = data.MetricsProtocol.ValueString()
+		resp.Diagnostics.Append(data.NodesAllowList.ElementsAs(ctx, &besuNodeConfig.NodesAllowList, false)...)
 	}
-
-	if !data.JWTEnabled.IsNull() {
-		besuNodeConfig["jwtEnabled"] = data.JWTEnabled.ValueBool()
-	}
-
-	if !data.JWTAuthenticationAlgorithm.IsNull() {
-		besuNodeConfig["jwtAuthenticationAlgorithm"] = data.JWTAuthenticationAlgorithm.ValueString()
-	}
-
-	if !data.JWTPublicKeyContent.IsNull() {
-		besuNodeConfig["jwtPublicKeyContent"] = data.JWTPublicKeyContent.ValueString()
-	}
-
 	if !data.Environment.IsNull() {
-		var env map[string]string
-		data.Environment.ElementsAs(ctx, &env, false)
-		besuNodeConfig["env"] = env
+		resp.Diagnostics.Append(data.Environment.ElementsAs(ctx, &besuNodeConfig.Env, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Build the create request according to API schema
 	// The API expects: blockchainPlatform (required) and besuNode object
-	createReq := map[string]interface{}{
-		"name":               data.Name.ValueString(),
-		"blockchainPlatform": "BESU",
-		"besuNode":           besuNodeConfig,
+	createReq := createNodeRequest{
+		Name:               data.Name.ValueString(),
+		BlockchainPlatform: models.TypesBlockchainPlatformBESU,
+		BesuNode:           besuNodeConfig,
 	}
 
-	body, err := r.client.DoRequest("POST", "/nodes", createReq)
+	body, err := r.client.DoRequest(ctx, "POST", "/nodes", createReq)
 	if err != nil {
 		// Parse response - even if there's an error, the response might contain node data
 		var errorResponse struct {
 			Message string `json:"message"`
 			Data    struct {
-				Node   *BesuNodeResponse `json:"node"`
-				NodeID int64             `json:"node_id"`
-				Stage  string            `json:"stage"`
+				Node   *models.HTTPNodeResponse `json:"node"`
+				NodeID int64                    `json:"node_id"`
+				Stage  string                   `json:"stage"`
 			} `json:"data"`
 		}
 
@@ -382,7 +350,7 @@ func (r *BesuNodeResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	var nodeResp BesuNodeResponse
+	var nodeResp models.HTTPNodeResponse
 	if err := json.Unmarshal(body, &nodeResp); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu node response, got error: %s", err))
 		return
@@ -409,6 +377,16 @@ func (r *BesuNodeResource) Create(ctx context.Context, req resource.CreateReques
 		)
 	}
 
+	// Park the node when it should not run
+	if data.DesiredState.ValueString() == desiredStateStopped {
+		stopped, err := reconcileNodeState(ctx, r.client, nodeResp.ID, desiredStateStopped, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to stop Besu node, got error: %s", err))
+		} else {
+			data.Status = types.StringValue(stopped.Status)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -420,28 +398,30 @@ func (r *BesuNodeResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		// Check for 404 - node was deleted outside of Terraform
-		if strings.Contains(err.Error(), "404") {
+		if IsNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Besu node, got error: %s", err))
 		return
 	}
-
-	var nodeResp BesuNodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu node response, got error: %s", err))
-		return
-	}
+	nodeResp := got.Payload
 
 	// Update state with response data
 	data.Name = types.StringValue(nodeResp.Name)
 	if nodeResp.Status != "" {
 		data.Status = types.StringValue(nodeResp.Status)
 	}
+	data.DesiredState = observedDesiredState(nodeResp.Status, data.DesiredState)
 	if nodeResp.CreatedAt != "" {
 		data.CreatedAt = types.StringValue(nodeResp.CreatedAt)
 	}
@@ -468,31 +448,68 @@ func (r *BesuNodeResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	// Build update request
-	updateReq := map[string]interface{}{
-		"name": data.Name.ValueString(),
-	}
-
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+	// Only send the node configuration when it changed; desired_state and
+	// restart_triggers alone are applied below
+	configChanged, err := nodeConfigChanged(req)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Besu node, got error: %s", err))
+		resp.Diagnostics.AddError("Plan Error", fmt.Sprintf("Unable to compare the planned Besu node configuration, got error: %s", err))
 		return
 	}
+	data.Status = state.Status
+	data.UpdatedAt = state.UpdatedAt
 
-	var nodeResp BesuNodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu node response, got error: %s", err))
+	if configChanged {
+		// Build update request
+		updateReq := updateNodeRequest{
+			Name: data.Name.ValueString(),
+		}
+
+		body, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Besu node, got error: %s", err))
+			return
+		}
+
+		var nodeResp models.HTTPNodeResponse
+		if err := json.Unmarshal(body, &nodeResp); err != nil {
+			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse Besu node response, got error: %s", err))
+			return
+		}
+
+		if nodeResp.Status != "" {
+			data.Status = types.StringValue(nodeResp.Status)
+		}
+		if nodeResp.UpdatedAt != "" {
+			data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
+		}
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	if nodeResp.Status != "" {
-		data.Status = types.StringValue(nodeResp.Status)
+	node, err := reconcileNodeState(ctx, r.client, id, data.DesiredState.ValueString(), restartRequested(state.RestartTriggers, data.RestartTriggers))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply desired_state to Besu node, got error: %s", err))
+		return
 	}
-	if nodeResp.UpdatedAt != "" {
-		data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
+	data.Status = types.StringValue(node.Status)
+	if node.UpdatedAt != "" {
+		data.UpdatedAt = types.StringValue(node.UpdatedAt)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -506,7 +523,22 @@ func (r *BesuNodeResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Nodes.DeleteNode(nodes.NewDeleteNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Besu node, got error: %s", err))
 		return
@@ -517,50 +549,34 @@ func (r *BesuNodeResource) ImportState(ctx context.Context, req resource.ImportS
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// waitForNodeRunning polls the node status until it reaches RUNNING state or timeout
+// waitForNodeRunning polls the node status until it reaches RUNNING state or ctx expires
 func (r *BesuNodeResource) waitForNodeRunning(ctx context.Context, nodeID int64) error {
-	maxAttempts := 30 // 30 attempts
-	delaySeconds := 2 // 2 seconds between attempts
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if context is cancelled
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("context cancelled while waiting for node to be running")
-		default:
-		}
+	var lastErr error
 
+	for {
 		// Call the node status endpoint
-		body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%d", nodeID), nil)
-		if err != nil {
-			// If it's just not ready yet, continue waiting
-			if attempt < maxAttempts {
-				time.Sleep(time.Duration(delaySeconds) * time.Second)
-				continue
-			}
-			return fmt.Errorf("failed to get node status after %d attempts: %s", maxAttempts, err)
-		}
-
-		var nodeResp BesuNodeResponse
-		if err := json.Unmarshal(body, &nodeResp); err != nil {
-			return fmt.Errorf("failed to parse node status response: %s", err)
-		}
+		got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(nodeID), nil)
+		if err == nil {
+			nodeResp := got.Payload
 
-		// Check if node is running
-		if nodeResp.Status == "RUNNING" {
-			return nil // Node is ready!
-		}
+			// Check if node is running
+			if nodeResp.Status == "RUNNING" {
+				return nil // Node is ready!
+			}
 
-		// If node is in error state, return error
-		if nodeResp.Status == "ERROR" || nodeResp.Status == "FAILED" {
-			return fmt.Errorf("node entered error state: %s", nodeResp.Status)
+			// If node is in error state, return error
+			if nodeResp.Status == "ERROR" || nodeResp.Status == "FAILED" {
+				return fmt.Errorf("node entered error state: %s", nodeResp.Status)
+			}
+			lastErr = fmt.Errorf("node status is %s", nodeResp.Status)
+		} else {
+			// If it's just not ready yet, continue waiting
+			lastErr = err
 		}
 
 		// Not ready yet, wait and try again
-		if attempt < maxAttempts {
-			time.Sleep(time.Duration(delaySeconds) * time.Second)
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return fmt.Errorf("timed out waiting for node to be running (%s): %w", lastErr, err)
 		}
 	}
-
-	return fmt.Errorf("node did not reach RUNNING state after %d attempts (%d seconds)", maxAttempts, maxAttempts*delaySeconds)
 }
@@ -0,0 +1,427 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &CertificateRenewalResource{}
+var _ resource.ResourceWithValidateConfig = &CertificateRenewalResource{}
+
+func NewCertificateRenewalResource() resource.Resource {
+	return &CertificateRenewalResource{}
+}
+
+// CertificateRenewalResource renews the certificates of a node or an
+// organization key whenever its triggers change.
+type CertificateRenewalResource struct {
+	client *Client
+}
+
+type CertificateRenewalResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	NodeID            types.Int64    `tfsdk:"node_id"`
+	OrganizationID    types.Int64    `tfsdk:"organization_id"`
+	KeyID             types.Int64    `tfsdk:"key_id"`
+	CAType            types.String   `tfsdk:"ca_type"`
+	Role              types.String   `tfsdk:"role"`
+	DNSNames          types.List     `tfsdk:"dns_names"`
+	IPAddresses       types.List     `tfsdk:"ip_addresses"`
+	ValidFor          types.String   `tfsdk:"valid_for"`
+	CertificatePEM    types.String   `tfsdk:"certificate_pem"`
+	SerialNumber      types.String   `tfsdk:"serial_number"`
+	NotAfter          types.String   `tfsdk:"not_after"`
+	SHA256Fingerprint types.String   `tfsdk:"sha256_fingerprint"`
+	RenewedAt         types.String   `tfsdk:"renewed_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *CertificateRenewalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_renewal"
+}
+
+func (r *CertificateRenewalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renews the certificates of a Fabric node, or the certificate of an organization key, when the resource is created. " +
+			"Change triggers (for example to a rotation date) to renew again. " +
+			"Node renewals wait for the node to be RUNNING again. " +
+			"Note: Deleting this resource only removes it from Terraform state - a renewal cannot be undone.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this renewal (format: node:<id>:<serial_number> or organization:<id>:<serial_number>).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Arbitrary values that renew the certificates again when they change, such as a rotation date.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"node_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the Fabric peer or orderer whose certificates are renewed. Conflicts with organization_id.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the organization whose key certificate is renewed. Conflicts with node_id.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"key_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the organization key whose certificate is renewed. Required with organization_id.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ca_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The organization CA that signs the renewed certificate: sign or tls. Required with organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "The role of the renewed certificate, e.g. admin, client, peer or orderer. Required with organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_names": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "DNS names of the renewed organization key certificate.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_addresses": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IP addresses of the renewed organization key certificate.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"valid_for": schema.StringAttribute{
+				Optional:    true,
+				Description: "Validity of the renewed organization key certificate as a Go duration (e.g. 8760h). Defaults to the server setting.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "The renewed certificate. For nodes, the signing certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "The serial number of the renewed certificate, as a hex string.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiry of the renewed certificate (RFC 3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha256_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SHA-256 fingerprint of the renewed certificate, as a hex string.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"renewed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the certificates were renewed (RFC 3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *CertificateRenewalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CertificateRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.NodeID.IsUnknown() || data.OrganizationID.IsUnknown() {
+		return
+	}
+
+	if data.NodeID.IsNull() == data.OrganizationID.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Renewal Target",
+			"Exactly one of node_id and organization_id must be set.",
+		)
+		return
+	}
+
+	orgOnly := map[string]bool{
+		"key_id":       !data.KeyID.IsNull(),
+		"ca_type":      !data.CAType.IsNull(),
+		"role":         !data.Role.IsNull(),
+		"dns_names":    !data.DNSNames.IsNull(),
+		"ip_addresses": !data.IPAddresses.IsNull(),
+		"valid_for":    !data.ValidFor.IsNull(),
+	}
+
+	if !data.NodeID.IsNull() {
+		for _, name := range []string{"key_id", "ca_type", "role", "dns_names", "ip_addresses", "valid_for"} {
+			if orgOnly[name] {
+				resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination",
+					fmt.Sprintf("%s only applies to organization key renewals and cannot be set with node_id.", name))
+			}
+		}
+		return
+	}
+
+	for _, name := range []string{"key_id", "ca_type", "role"} {
+		if !orgOnly[name] {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Attribute",
+				fmt.Sprintf("%s is required to renew an organization key.", name))
+		}
+	}
+
+	if !data.CAType.IsUnknown() && !data.CAType.IsNull() {
+		if caType := data.CAType.ValueString(); caType != "sign" && caType != "tls" {
+			resp.Diagnostics.AddAttributeError(path.Root("ca_type"), "Invalid CA Type",
+				fmt.Sprintf("ca_type must be sign or tls, got %q.", caType))
+		}
+	}
+}
+
+func (r *CertificateRenewalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CertificateRenewalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CertificateRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var target string
+	var targetID int64
+	var certPEM string
+	if !data.NodeID.IsNull() {
+		target, targetID = "node", data.NodeID.ValueInt64()
+
+		var err error
+		certPEM, err = r.renewNode(ctx, targetID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to renew node certificates: %s", err))
+			return
+		}
+	} else {
+		target, targetID = "organization", data.OrganizationID.ValueInt64()
+
+		var dnsNames, ipAddresses []string
+		resp.Diagnostics.Append(data.DNSNames.ElementsAs(ctx, &dnsNames, true)...)
+		resp.Diagnostics.Append(data.IPAddresses.ElementsAs(ctx, &ipAddresses, true)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		params := organizations.NewRenewOrganizationCertificateParamsWithContext(ctx).
+			WithID(targetID).
+			WithRequest(&models.HandlerRenewCertificateRequest{
+				KeyID:       data.KeyID.ValueInt64Pointer(),
+				CaType:      data.CAType.ValueStringPointer(),
+				Role:        data.Role.ValueStringPointer(),
+				DNSNames:    dnsNames,
+				IPAddresses: ipAddresses,
+				ValidFor:    data.ValidFor.ValueString(),
+			})
+		renewed, err := r.client.API.Organizations.RenewOrganizationCertificate(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to renew organization key certificate, got error: %s", err))
+			return
+		}
+		certPEM = renewed.Payload.Certificate
+	}
+
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		resp.Diagnostics.AddError("Certificate Error", fmt.Sprintf("Unable to read the renewed certificate: %s", err))
+		return
+	}
+	fingerprint := sha256.Sum256(cert.Raw)
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%d:%s", target, targetID, cert.SerialNumber.Text(16)))
+	data.CertificatePEM = types.StringValue(certPEM)
+	data.SerialNumber = types.StringValue(cert.SerialNumber.Text(16))
+	data.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	data.SHA256Fingerprint = types.StringValue(hex.EncodeToString(fingerprint[:]))
+	data.RenewedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// renewNode renews the certificates of a node, waits for it to be RUNNING
+// with a new signing certificate and returns that certificate.
+func (r *CertificateRenewalResource) renewNode(ctx context.Context, nodeID int64) (string, error) {
+	before, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(nodeID), nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to read node: %w", err)
+	}
+	if nodeSignCert(before.Payload) == "" {
+		return "", fmt.Errorf("node %d has no signing certificate to renew", nodeID)
+	}
+
+	if _, err := r.client.API.Nodes.RenewNodeCertificates(nodes.NewRenewNodeCertificatesParamsWithContext(ctx).WithID(nodeID), nil); err != nil {
+		return "", fmt.Errorf("renewal failed: %w", err)
+	}
+
+	// The node restarts with its new certificates; until then it may still
+	// report RUNNING with the old ones
+	for {
+		got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(nodeID), nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return "", fmt.Errorf("timed out waiting for node to reach RUNNING state: %w", ctx.Err())
+			}
+			return "", fmt.Errorf("failed to check node status: %w", err)
+		}
+		node := got.Payload
+
+		if cert := nodeSignCert(node); node.Status == "RUNNING" && cert != "" && cert != nodeSignCert(before.Payload) {
+			return cert, nil
+		}
+
+		if node.Status == "ERROR" {
+			return "", fmt.Errorf("node entered ERROR state after the renewal: %s", node.ErrorMessage)
+		}
+
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return "", fmt.Errorf("timed out waiting for node to reach RUNNING state with renewed certificates (last status %q): %w", node.Status, err)
+		}
+	}
+}
+
+// nodeSignCert returns the signing certificate of a Fabric peer or orderer.
+func nodeSignCert(node *models.HTTPNodeResponse) string {
+	switch {
+	case node == nil:
+		return ""
+	case node.FabricPeer != nil:
+		return node.FabricPeer.SignCert
+	case node.FabricOrderer != nil:
+		return node.FabricOrderer.SignCert
+	}
+	return ""
+}
+
+func (r *CertificateRenewalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CertificateRenewalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A renewal is a one-off action, so we only verify that its target still exists
+	var err error
+	if !data.NodeID.IsNull() {
+		_, err = r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(data.NodeID.ValueInt64()), nil)
+	} else {
+		_, err = r.client.API.Organizations.GetFabricOrganization(organizations.NewGetFabricOrganizationParamsWithContext(ctx).WithID(data.OrganizationID.ValueInt64()), nil)
+	}
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read renewal target, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateRenewalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute forces a replacement, so there is nothing to send here.
+	var data CertificateRenewalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CertificateRenewalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Renewed certificates cannot be rolled back, so deletion only removes the
+	// resource from Terraform state without making any API calls
+}
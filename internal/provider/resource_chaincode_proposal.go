@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &ChaincodeProposalResource{}
+
+func NewChaincodeProposalResource() resource.Resource {
+	return &ChaincodeProposalResource{}
+}
+
+// ChaincodeProposalResource proposes a chaincode definition to the other
+// organizations of a Fabric network.
+type ChaincodeProposalResource struct {
+	client *Client
+}
+
+type ChaincodeProposalResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	NetworkID           types.Int64  `tfsdk:"network_id"`
+	ChannelName         types.String `tfsdk:"channel_name"`
+	Name                types.String `tfsdk:"name"`
+	Version             types.String `tfsdk:"version"`
+	Sequence            types.Int64  `tfsdk:"sequence"`
+	DockerImage         types.String `tfsdk:"docker_image"`
+	EndorsementPolicy   types.String `tfsdk:"endorsement_policy"`
+	ChaincodeAddress    types.String `tfsdk:"chaincode_address"`
+	SharedConnectionIDs types.Set    `tfsdk:"shared_connection_ids"`
+	Status              types.String `tfsdk:"status"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+}
+
+func (r *ChaincodeProposalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chaincode_proposal"
+}
+
+func (r *ChaincodeProposalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Proposes a chaincode definition (name, version, sequence, image and endorsement policy) to the other organizations of a Fabric network (Chainlaunch Pro). " +
+			"Partner instances accept it with chainlaunch_chaincode_proposal_acceptance and approve the resulting definition. " +
+			"Note: Deleting this resource only removes it from Terraform state - proposals cannot be withdrawn.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the proposal.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the Fabric network the chaincode is defined on.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The channel the chaincode is defined on.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the chaincode.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Required:    true,
+				Description: "The version of the chaincode definition.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sequence": schema.Int64Attribute{
+				Required:    true,
+				Description: "The sequence of the chaincode definition, incremented for every upgrade.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"docker_image": schema.StringAttribute{
+				Required:    true,
+				Description: "The Docker image that runs the chaincode.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"endorsement_policy": schema.StringAttribute{
+				Optional:    true,
+				Description: "The endorsement policy, such as OR('Org1MSP.member','Org2MSP.member'). The channel default applies when unset.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"chaincode_address": schema.StringAttribute{
+				Optional:    true,
+				Description: "The address the chaincode service listens on, for chaincode as a service.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shared_connection_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the node sharing connections of the instances the proposal is shared with. " +
+					"Adding an ID shares the proposal; removing one does not unshare it.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the proposal.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the proposal was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChaincodeProposalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ChaincodeProposalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChaincodeProposalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.API.Chaincode.CreateChaincodeProposal(chaincode.NewCreateChaincodeProposalParamsWithContext(ctx).WithRequest(&models.ProCreateChaincodeProposalRequest{
+		NetworkID:         data.NetworkID.ValueInt64(),
+		ChannelName:       data.ChannelName.ValueString(),
+		Name:              data.Name.ValueString(),
+		Version:           data.Version.ValueString(),
+		Sequence:          data.Sequence.ValueInt64(),
+		DockerImage:       data.DockerImage.ValueString(),
+		EndorsementPolicy: data.EndorsementPolicy.ValueString(),
+		ChaincodeAddress:  data.ChaincodeAddress.ValueString(),
+	}), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create chaincode proposal, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(created.Payload.ID, 10))
+	data.Status = stringValueOrNull(created.Payload.Status)
+	data.CreatedAt = stringValueOrNull(created.Payload.CreatedAt)
+
+	// A proposal that failed to be shared is abandoned rather than kept in
+	// state, just as deleting it would
+	resp.Diagnostics.Append(r.share(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChaincodeProposalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChaincodeProposalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, err := r.client.API.Chaincode.GetChaincodeProposal(chaincode.NewGetChaincodeProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read chaincode proposal, got error: %s", err))
+		return
+	}
+
+	if proposal := got.Payload.Proposal; proposal != nil {
+		data.Status = stringValueOrNull(proposal.Status)
+		data.CreatedAt = stringValueOrNull(proposal.CreatedAt)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChaincodeProposalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ChaincodeProposalResourceModel
+	var state ChaincodeProposalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the sharing changes in place
+	data.Status = state.Status
+	resp.Diagnostics.Append(r.share(ctx, &data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChaincodeProposalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The API has no endpoint to withdraw a chaincode proposal, so deletion
+	// only removes the resource from Terraform state
+}
+
+// share shares the proposal with the connections of data that are not in
+// state. A nil state shares it with all of them.
+func (r *ChaincodeProposalResource) share(ctx context.Context, data, state *ChaincodeProposalResourceModel) diag.Diagnostics {
+	previous := types.SetNull(types.Int64Type)
+	if state != nil {
+		previous = state.SharedConnectionIDs
+	}
+	recipients, diags := addedConnectionIDs(ctx, data.SharedConnectionIDs, previous)
+	if diags.HasError() || len(recipients) == 0 {
+		return diags
+	}
+
+	params := chaincode.NewShareChaincodeProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()).WithRequest(&models.ProShareChaincodeProposalRequest{
+		Recipients: recipients,
+	})
+	shareResp, err := r.client.API.Chaincode.ShareChaincodeProposal(params, nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to share chaincode proposal, got error: %s", err))
+		return diags
+	}
+	if !shareResp.Payload.Success {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to share chaincode proposal: %s", shareResp.Payload.Error))
+	}
+	return diags
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &ChaincodeProposalAcceptanceResource{}
+
+func NewChaincodeProposalAcceptanceResource() resource.Resource {
+	return &ChaincodeProposalAcceptanceResource{}
+}
+
+// ChaincodeProposalAcceptanceResource accepts a chaincode proposal shared by
+// another organization, creating the chaincode and its definition locally.
+type ChaincodeProposalAcceptanceResource struct {
+	client *Client
+}
+
+type ChaincodeProposalAcceptanceResourceModel struct {
+	ID                types.String `tfsdk:"id"`
+	ProposalID        types.String `tfsdk:"proposal_id"`
+	ChaincodeID       types.Int64  `tfsdk:"chaincode_id"`
+	DefinitionID      types.Int64  `tfsdk:"definition_id"`
+	NetworkID         types.Int64  `tfsdk:"network_id"`
+	ChannelName       types.String `tfsdk:"channel_name"`
+	Name              types.String `tfsdk:"name"`
+	Version           types.String `tfsdk:"version"`
+	Sequence          types.Int64  `tfsdk:"sequence"`
+	DockerImage       types.String `tfsdk:"docker_image"`
+	EndorsementPolicy types.String `tfsdk:"endorsement_policy"`
+	SharedBy          types.String `tfsdk:"shared_by"`
+	Status            types.String `tfsdk:"status"`
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chaincode_proposal_acceptance"
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Accepts a chaincode proposal shared by another organization (Chainlaunch Pro), creating the chaincode and its definition on this instance. " +
+			"Pass definition_id to chainlaunch_fabric_chaincode_install and chainlaunch_fabric_chaincode_approve to approve it for the local organization. " +
+			"Note: Deleting this resource only removes it from Terraform state - the definition is kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the acceptance, the proposal ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proposal_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the proposal, from the proposals of the chainlaunch_shared_chaincodes data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"chaincode_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the chaincode created on this instance.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"definition_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the chaincode definition created on this instance.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the Fabric network the chaincode is defined on.",
+			},
+			"channel_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The channel the chaincode is defined on.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the chaincode.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the chaincode definition.",
+			},
+			"sequence": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The sequence of the chaincode definition.",
+			},
+			"docker_image": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Docker image that runs the chaincode.",
+			},
+			"endorsement_policy": schema.StringAttribute{
+				Computed:    true,
+				Description: "The endorsement policy of the chaincode definition.",
+			},
+			"shared_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The instance that proposed the chaincode definition.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the proposal.",
+			},
+		},
+	}
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ChaincodeProposalAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accepted, err := r.client.API.Chaincode.AcceptChaincodeProposal(chaincode.NewAcceptChaincodeProposalParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to accept chaincode proposal, got error: %s", err))
+		return
+	}
+
+	data.ID = data.ProposalID
+	setChaincodeProposalAcceptance(&data, accepted.Payload)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ChaincodeProposalAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, err := r.client.API.Chaincode.GetChaincodeProposal(chaincode.NewGetChaincodeProposalParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read chaincode proposal, got error: %s", err))
+		return
+	}
+
+	setChaincodeProposalAcceptance(&data, got.Payload)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// A proposal is accepted only once; every attribute that can be
+	// configured forces a replacement, so there is nothing to send here.
+	var data ChaincodeProposalAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChaincodeProposalAcceptanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Accepting created a chaincode definition that may already be approved,
+	// and the API has no endpoint to undo an acceptance, so deletion only
+	// removes the resource from Terraform state
+}
+
+// setChaincodeProposalAcceptance copies the proposal, chaincode and
+// definition returned by the API into data. Values the API leaves out keep
+// their previous value, or become null on creation.
+func setChaincodeProposalAcceptance(data *ChaincodeProposalAcceptanceResourceModel, accepted *models.ProChaincodeDefinitionWithChaincode) {
+	if data.ChaincodeID.IsUnknown() {
+		data.ChaincodeID = types.Int64Null()
+	}
+	if data.DefinitionID.IsUnknown() {
+		data.DefinitionID = types.Int64Null()
+	}
+
+	if proposal := accepted.Proposal; proposal != nil {
+		data.NetworkID = types.Int64Value(proposal.NetworkID)
+		data.ChannelName = stringValueOrNull(proposal.ChannelName)
+		data.Name = stringValueOrNull(proposal.Name)
+		data.Version = stringValueOrNull(proposal.Version)
+		data.Sequence = types.Int64Value(proposal.Sequence)
+		data.DockerImage = stringValueOrNull(proposal.DockerImage)
+		data.EndorsementPolicy = stringValueOrNull(proposal.EndorsementPolicy)
+		data.SharedBy = stringValueOrNull(proposal.SharedBy)
+		data.Status = stringValueOrNull(proposal.Status)
+	} else if data.Status.IsUnknown() {
+		data.NetworkID = types.Int64Null()
+		data.ChannelName = types.StringNull()
+		data.Name = types.StringNull()
+		data.Version = types.StringNull()
+		data.Sequence = types.Int64Null()
+		data.DockerImage = types.StringNull()
+		data.EndorsementPolicy = types.StringNull()
+		data.SharedBy = types.StringNull()
+		data.Status = types.StringNull()
+	}
+
+	if accepted.Chaincode != nil && accepted.Chaincode.ID != 0 {
+		data.ChaincodeID = types.Int64Value(accepted.Chaincode.ID)
+	}
+	if definition := accepted.Definition; definition != nil && definition.ID != 0 {
+		data.DefinitionID = types.Int64Value(definition.ID)
+		// The definition accepted locally is authoritative over the proposal
+		data.Version = stringValueOrNull(definition.Version)
+		data.Sequence = types.Int64Value(definition.Sequence)
+		data.DockerImage = stringValueOrNull(definition.DockerImage)
+		data.EndorsementPolicy = stringValueOrNull(definition.EndorsementPolicy)
+	}
+}
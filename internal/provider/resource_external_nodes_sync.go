@@ -2,13 +2,15 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/node_sharing"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &ExternalNodesSyncResource{}
@@ -184,83 +186,38 @@ func (r *ExternalNodesSyncResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ExternalNodesSyncResource) performSync(ctx context.Context, data *ExternalNodesSyncResourceModel) error {
-	syncReq := map[string]interface{}{
-		"peer_node_id": data.PeerNodeID.ValueString(),
+	syncReq := &models.CommunicationSyncExternalNodesRequest{
+		PeerNodeID: data.PeerNodeID.ValueString(),
 	}
 
-	body, err := r.client.DoRequest("POST", "/node/sync-external-nodes", syncReq)
+	synced, err := r.client.API.NodeSharing.SyncExternalNodes(node_sharing.NewSyncExternalNodesParamsWithContext(ctx).WithRequest(syncReq), nil)
 	if err != nil {
 		return err
 	}
-
-	var syncResp map[string]interface{}
-	if err := json.Unmarshal(body, &syncResp); err != nil {
-		return fmt.Errorf("unable to parse sync response: %w", err)
-	}
+	syncResp := synced.Payload
 
 	// Update state with sync results
 	data.ID = types.StringValue(fmt.Sprintf("%s-%d", data.PeerNodeID.ValueString(), time.Now().Unix()))
 	data.LastSyncAt = types.StringValue(time.Now().Format(time.RFC3339))
 
-	if orgsAdded, ok := syncResp["organizations_added"].(float64); ok {
-		data.OrganizationsAdded = types.Int64Value(int64(orgsAdded))
-	} else {
-		data.OrganizationsAdded = types.Int64Value(0)
-	}
-
-	if peersAdded, ok := syncResp["fabric_peers_added"].(float64); ok {
-		data.FabricPeersAdded = types.Int64Value(int64(peersAdded))
-	} else {
-		data.FabricPeersAdded = types.Int64Value(0)
-	}
-
-	if peersDeleted, ok := syncResp["fabric_peers_deleted"].(float64); ok {
-		data.FabricPeersDeleted = types.Int64Value(int64(peersDeleted))
-	} else {
-		data.FabricPeersDeleted = types.Int64Value(0)
-	}
-
-	if orderersAdded, ok := syncResp["fabric_orderers_added"].(float64); ok {
-		data.FabricOrderersAdded = types.Int64Value(int64(orderersAdded))
-	} else {
-		data.FabricOrderersAdded = types.Int64Value(0)
-	}
-
-	if orderersDeleted, ok := syncResp["fabric_orderers_deleted"].(float64); ok {
-		data.FabricOrderersDeleted = types.Int64Value(int64(orderersDeleted))
-	} else {
-		data.FabricOrderersDeleted = types.Int64Value(0)
-	}
-
-	if besuAdded, ok := syncResp["besu_nodes_added"].(float64); ok {
-		data.BesuNodesAdded = types.Int64Value(int64(besuAdded))
-	} else {
-		data.BesuNodesAdded = types.Int64Value(0)
-	}
-
-	if besuDeleted, ok := syncResp["besu_nodes_deleted"].(float64); ok {
-		data.BesuNodesDeleted = types.Int64Value(int64(besuDeleted))
-	} else {
-		data.BesuNodesDeleted = types.Int64Value(0)
-	}
+	data.OrganizationsAdded = types.Int64Value(syncResp.OrganizationsAdded)
+	data.FabricPeersAdded = types.Int64Value(syncResp.FabricPeersAdded)
+	data.FabricPeersDeleted = types.Int64Value(syncResp.FabricPeersDeleted)
+	data.FabricOrderersAdded = types.Int64Value(syncResp.FabricOrderersAdded)
+	data.FabricOrderersDeleted = types.Int64Value(syncResp.FabricOrderersDeleted)
+	data.BesuNodesAdded = types.Int64Value(syncResp.BesuNodesAdded)
+	data.BesuNodesDeleted = types.Int64Value(syncResp.BesuNodesDeleted)
 
 	// Handle errors list
-	if errors, ok := syncResp["errors"].([]interface{}); ok && len(errors) > 0 {
-		errorStrings := make([]string, len(errors))
-		for i, e := range errors {
-			if errStr, ok := e.(string); ok {
-				errorStrings[i] = errStr
-			}
-		}
-		errorList, diags := types.ListValueFrom(ctx, types.StringType, errorStrings)
-		if diags.HasError() {
-			return fmt.Errorf("unable to convert errors list")
-		}
-		data.Errors = errorList
-	} else {
-		emptyList, _ := types.ListValueFrom(ctx, types.StringType, []string{})
-		data.Errors = emptyList
+	errorStrings := syncResp.Errors
+	if errorStrings == nil {
+		errorStrings = []string{}
+	}
+	errorList, diags := types.ListValueFrom(ctx, types.StringType, errorStrings)
+	if diags.HasError() {
+		return fmt.Errorf("unable to convert errors list")
 	}
+	data.Errors = errorList
 
 	return nil
 }
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -14,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricAddNodeResource{}
@@ -106,26 +108,17 @@ func (r *FabricAddNodeResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	addNodeReq := AddNodeToNetworkRequest{
-		NodeID: data.NodeID.ValueInt64(),
-		Role:   data.Role.ValueString(),
+	addNodeReq := &models.HTTPAddNodeToNetworkRequest{
+		NodeID: data.NodeID.ValueInt64Pointer(),
+		Role:   data.Role.ValueStringPointer(),
 	}
 
-	endpoint := fmt.Sprintf("/networks/fabric/%d/nodes", data.NetworkID.ValueInt64())
-
-	body, err := r.client.DoRequest("POST", endpoint, addNodeReq)
-	if err != nil {
+	params := fabric_networks.NewAddFabricNetworkNodeParamsWithContext(ctx).WithID(data.NetworkID.ValueInt64()).WithRequest(addNodeReq)
+	if _, err := r.client.API.FabricNetworks.AddFabricNetworkNode(params, nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add node to network, got error: %s", err))
 		return
 	}
 
-	// Parse response to verify success
-	var networkResp FabricNetworkResponse
-	if err := json.Unmarshal(body, &networkResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response: %s", err))
-		return
-	}
-
 	// Set the composite ID
 	data.ID = types.StringValue(fmt.Sprintf("%d:%d", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64()))
 
@@ -141,24 +134,21 @@ func (r *FabricAddNodeResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get the list of nodes in the network
-	endpoint := fmt.Sprintf("/networks/fabric/%d/nodes", data.NetworkID.ValueInt64())
-
-	body, err := r.client.DoRequest("GET", endpoint, nil)
+	params := fabric_networks.NewGetFabricNetworkNodesParamsWithContext(ctx).WithID(data.NetworkID.ValueInt64())
+	nodesResp, err := r.client.API.FabricNetworks.GetFabricNetworkNodes(params, nil)
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network nodes, got error: %s", err))
 		return
 	}
 
-	var nodesResp GetNetworkNodesResponse
-	if err := json.Unmarshal(body, &nodesResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse nodes response: %s", err))
-		return
-	}
-
 	// Check if the node is still in the network
 	found := false
-	for _, node := range nodesResp.Nodes {
-		if node.NodeID == data.NodeID.ValueInt64() && node.Role == data.Role.ValueString() {
+	for _, node := range nodesResp.Payload.Nodes {
+		if node != nil && node.NodeID == data.NodeID.ValueInt64() && node.Role == data.Role.ValueString() {
 			found = true
 			break
 		}
@@ -190,18 +180,20 @@ func (r *FabricAddNodeResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	// Determine the endpoint based on role
-	var endpoint string
-	if data.Role.ValueString() == "peer" {
-		endpoint = fmt.Sprintf("/networks/fabric/%d/peers/%d", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64())
-	} else if data.Role.ValueString() == "orderer" {
-		endpoint = fmt.Sprintf("/networks/fabric/%d/orderers/%d", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64())
-	} else {
+	networkID, nodeID := data.NetworkID.ValueInt64(), data.NodeID.ValueInt64()
+
+	var err error
+	switch data.Role.ValueString() {
+	case "peer":
+		params := fabric_networks.NewRemoveFabricPeerParamsWithContext(ctx).WithID(networkID).WithPeerID(nodeID)
+		_, err = r.client.API.FabricNetworks.RemoveFabricPeer(params, nil)
+	case "orderer":
+		params := fabric_networks.NewRemoveFabricOrdererParamsWithContext(ctx).WithID(networkID).WithOrdererID(nodeID)
+		_, err = r.client.API.FabricNetworks.RemoveFabricOrderer(params, nil)
+	default:
 		resp.Diagnostics.AddError("Invalid Role", fmt.Sprintf("Unknown role: %s", data.Role.ValueString()))
 		return
 	}
-
-	_, err := r.client.DoRequest("DELETE", endpoint, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove node from network, got error: %s", err))
 		return
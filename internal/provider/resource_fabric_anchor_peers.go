@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -14,6 +13,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricAnchorPeersResource{}
@@ -98,25 +101,15 @@ func (r *FabricAnchorPeersResource) Configure(ctx context.Context, req resource.
 
 // setAnchorPeersWithRetry performs the anchor peers API call with exponential backoff
 // This is necessary because consenters may not be available immediately after network creation
-func (r *FabricAnchorPeersResource) setAnchorPeersWithRetry(ctx context.Context, networkID int64, organizationID int64, anchorPeers []interface{}) (string, error) {
-	// AnchorPeer type defined for documentation purposes
-	// nolint:unused
-	type AnchorPeer struct {
-		Host string `json:"host"`
-		Port int    `json:"port"`
-	}
-
-	setAnchorPeersReq := struct {
-		OrganizationID int64       `json:"organizationId"`
-		AnchorPeers    interface{} `json:"anchorPeers"`
-	}{
-		OrganizationID: organizationID,
-		AnchorPeers:    anchorPeers,
-	}
-
-	endpoint := fmt.Sprintf("/networks/fabric/%d/anchor-peers", networkID)
-
-	// Exponential backoff: 500ms, 1s, 2s, 4s, 5s (max), 5s, ...
+func (r *FabricAnchorPeersResource) setAnchorPeersWithRetry(ctx context.Context, networkID int64, organizationID int64, anchorPeers []*models.HTTPAnchorPeer) (string, error) {
+	params := fabric_networks.NewSetFabricAnchorPeersParamsWithContext(ctx).
+		WithID(networkID).
+		WithRequest(&models.HTTPSetAnchorPeersRequest{
+			OrganizationID: &organizationID,
+			AnchorPeers:    anchorPeers,
+		})
+
+	// Exponential backoff: ~500ms, 1s, 2s, 4s, 5s (max), 5s, ...
 	// Total attempts: up to 10 retries over ~30 seconds
 	maxRetries := 10
 	baseDelay := 500 * time.Millisecond
@@ -124,20 +117,9 @@ func (r *FabricAnchorPeersResource) setAnchorPeersWithRetry(ctx context.Context,
 
 	var lastErr error
 	for attempt := 0; attempt <= maxRetries; attempt++ {
-		body, err := r.client.DoRequest("POST", endpoint, setAnchorPeersReq)
+		setResp, err := r.client.API.FabricNetworks.SetFabricAnchorPeers(params, nil)
 		if err == nil {
-			// Success - parse and return transaction ID
-			var setResp struct {
-				TransactionID string `json:"transactionId"`
-			}
-			if err := json.Unmarshal(body, &setResp); err != nil {
-				return "", fmt.Errorf("unable to parse response: %s", err)
-			}
-			if attempt > 0 {
-				// Log that we succeeded after retries
-				return setResp.TransactionID, nil
-			}
-			return setResp.TransactionID, nil
+			return setResp.Payload.TransactionID, nil
 		}
 
 		lastErr = err
@@ -147,11 +129,8 @@ func (r *FabricAnchorPeersResource) setAnchorPeersWithRetry(ctx context.Context,
 			break
 		}
 
-		// Calculate exponential backoff with cap at maxDelay
-		delay := baseDelay * (1 << attempt) // 500ms * 2^attempt
-		if delay > maxDelay {
-			delay = maxDelay
-		}
+		// Same exponential backoff with jitter as the client, capped at maxDelay
+		delay := retryBackoff(attempt, baseDelay, maxDelay)
 
 		// Wait before next attempt
 		select {
@@ -165,6 +144,39 @@ func (r *FabricAnchorPeersResource) setAnchorPeersWithRetry(ctx context.Context,
 	return "", fmt.Errorf("failed after %d attempts (last error: %s)", maxRetries+1, lastErr)
 }
 
+// anchorPeers looks up the external endpoint of each peer and returns it as an anchor peer.
+func (r *FabricAnchorPeersResource) anchorPeers(ctx context.Context, peerIDs []int64) ([]*models.HTTPAnchorPeer, error) {
+	anchorPeers := make([]*models.HTTPAnchorPeer, 0, len(peerIDs))
+	for _, peerID := range peerIDs {
+		peer, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(peerID), nil)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read peer %d: %w", peerID, err)
+		}
+
+		if peer.Payload.FabricPeer == nil || peer.Payload.FabricPeer.ExternalEndpoint == "" {
+			return nil, fmt.Errorf("peer %d does not have externalEndpoint", peerID)
+		}
+		externalEndpoint := peer.Payload.FabricPeer.ExternalEndpoint
+
+		// Parse host:port using strings.Split
+		parts := strings.Split(externalEndpoint, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid endpoint format %s (expected host:port)", externalEndpoint)
+		}
+
+		port, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse port in endpoint %s: %w", externalEndpoint, err)
+		}
+
+		anchorPeers = append(anchorPeers, &models.HTTPAnchorPeer{
+			Host: &parts[0],
+			Port: &port,
+		})
+	}
+	return anchorPeers, nil
+}
+
 func (r *FabricAnchorPeersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FabricAnchorPeersResourceModel
 
@@ -181,66 +193,15 @@ func (r *FabricAnchorPeersResource) Create(ctx context.Context, req resource.Cre
 	}
 
 	// Build the request - need to get peer host:port from the API
-	// First, fetch each peer to get their endpoints
-	type AnchorPeer struct {
-		Host string `json:"host"`
-		Port int    `json:"port"`
-	}
-
-	var anchorPeers []AnchorPeer
-	for _, peerID := range anchorPeerIDs {
-		// Get peer details
-		peerBody, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%d", peerID), nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read peer %d: %s", peerID, err))
-			return
-		}
-
-		var peerResp struct {
-			FabricPeer map[string]interface{} `json:"fabricPeer"`
-		}
-		if err := json.Unmarshal(peerBody, &peerResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peer %d response: %s", peerID, err))
-			return
-		}
-
-		// Extract external endpoint
-		if externalEndpoint, ok := peerResp.FabricPeer["externalEndpoint"].(string); ok {
-			// Parse host:port using strings.Split
-			parts := strings.Split(externalEndpoint, ":")
-			if len(parts) != 2 {
-				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Invalid endpoint format %s (expected host:port)", externalEndpoint))
-				return
-			}
-
-			port, err := strconv.Atoi(parts[1])
-			if err != nil {
-				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse port in endpoint %s: %s", externalEndpoint, err))
-				return
-			}
-
-			anchorPeers = append(anchorPeers, AnchorPeer{
-				Host: parts[0],
-				Port: port,
-			})
-		} else {
-			resp.Diagnostics.AddError("Missing Field", fmt.Sprintf("Peer %d does not have externalEndpoint", peerID))
-			return
-		}
-	}
-
-	// Convert to []interface{} for the retry function
-	anchorPeersInterface := make([]interface{}, len(anchorPeers))
-	for i, ap := range anchorPeers {
-		anchorPeersInterface[i] = map[string]interface{}{
-			"host": ap.Host,
-			"port": ap.Port,
-		}
+	anchorPeers, err := r.anchorPeers(ctx, anchorPeerIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve anchor peers: %s", err))
+		return
 	}
 
 	// Use retry function with exponential backoff
 	// This handles the case where consenters are not available immediately after network creation
-	transactionID, err := r.setAnchorPeersWithRetry(ctx, data.NetworkID.ValueInt64(), data.OrganizationID.ValueInt64(), anchorPeersInterface)
+	transactionID, err := r.setAnchorPeersWithRetry(ctx, data.NetworkID.ValueInt64(), data.OrganizationID.ValueInt64(), anchorPeers)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set anchor peers: %s", err))
 		return
@@ -261,8 +222,18 @@ func (r *FabricAnchorPeersResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	// The API doesn't provide a GET endpoint for anchor peers, so we just keep the state as-is
-	// In a real implementation, you might query the network config block to verify
+	// The API doesn't provide a GET endpoint for anchor peers, so we only verify that
+	// the network still exists and otherwise keep the state as-is
+	_, err := r.client.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(data.NetworkID.ValueInt64()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fabric network, got error: %s", err))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -282,64 +253,14 @@ func (r *FabricAnchorPeersResource) Update(ctx context.Context, req resource.Upd
 	}
 
 	// Build the request - need to get peer host:port from the API
-	type AnchorPeer struct {
-		Host string `json:"host"`
-		Port int    `json:"port"`
-	}
-
-	var anchorPeers []AnchorPeer
-	for _, peerID := range anchorPeerIDs {
-		// Get peer details
-		peerBody, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%d", peerID), nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read peer %d: %s", peerID, err))
-			return
-		}
-
-		var peerResp struct {
-			FabricPeer map[string]interface{} `json:"fabricPeer"`
-		}
-		if err := json.Unmarshal(peerBody, &peerResp); err != nil {
-			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peer %d response: %s", peerID, err))
-			return
-		}
-
-		// Extract external endpoint
-		if externalEndpoint, ok := peerResp.FabricPeer["externalEndpoint"].(string); ok {
-			// Parse host:port using strings.Split
-			parts := strings.Split(externalEndpoint, ":")
-			if len(parts) != 2 {
-				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Invalid endpoint format %s (expected host:port)", externalEndpoint))
-				return
-			}
-
-			port, err := strconv.Atoi(parts[1])
-			if err != nil {
-				resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse port in endpoint %s: %s", externalEndpoint, err))
-				return
-			}
-
-			anchorPeers = append(anchorPeers, AnchorPeer{
-				Host: parts[0],
-				Port: port,
-			})
-		} else {
-			resp.Diagnostics.AddError("Missing Field", fmt.Sprintf("Peer %d does not have externalEndpoint", peerID))
-			return
-		}
-	}
-
-	// Convert to []interface{} for the retry function
-	anchorPeersInterface := make([]interface{}, len(anchorPeers))
-	for i, ap := range anchorPeers {
-		anchorPeersInterface[i] = map[string]interface{}{
-			"host": ap.Host,
-			"port": ap.Port,
-		}
+	anchorPeers, err := r.anchorPeers(ctx, anchorPeerIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve anchor peers: %s", err))
+		return
 	}
 
 	// Use retry function with exponential backoff
-	transactionID, err := r.setAnchorPeersWithRetry(ctx, data.NetworkID.ValueInt64(), data.OrganizationID.ValueInt64(), anchorPeersInterface)
+	transactionID, err := r.setAnchorPeersWithRetry(ctx, data.NetworkID.ValueInt64(), data.OrganizationID.ValueInt64(), anchorPeers)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set anchor peers: %s", err))
 		return
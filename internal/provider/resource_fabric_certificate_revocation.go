@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &FabricCertificateRevocationResource{}
+var _ resource.ResourceWithImportState = &FabricCertificateRevocationResource{}
+var _ resource.ResourceWithValidateConfig = &FabricCertificateRevocationResource{}
+
+func NewFabricCertificateRevocationResource() resource.Resource {
+	return &FabricCertificateRevocationResource{}
+}
+
+// FabricCertificateRevocationResource adds a certificate to the CRL of a
+// Fabric organization and removes it again on destroy.
+type FabricCertificateRevocationResource struct {
+	client *Client
+}
+
+type FabricCertificateRevocationResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	OrganizationID   types.Int64  `tfsdk:"organization_id"`
+	SerialNumber     types.String `tfsdk:"serial_number"`
+	CertificatePEM   types.String `tfsdk:"certificate_pem"`
+	RevocationReason types.Int64  `tfsdk:"revocation_reason"`
+	RevocationTime   types.String `tfsdk:"revocation_time"`
+}
+
+func (r *FabricCertificateRevocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_certificate_revocation"
+}
+
+func (r *FabricCertificateRevocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Revokes a certificate issued by a Fabric organization, adding it to the organization's certificate revocation list (CRL). " +
+			"Destroying the resource un-revokes the certificate. Use chainlaunch_fabric_organization_crl to publish the CRL to a channel.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this resource (format: organization_id:serial_number).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the organization that issued the certificate.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The serial number of the certificate to revoke, as a hex string. Computed from certificate_pem when that is set instead.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM encoded certificate to revoke. Conflicts with serial_number.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revocation_reason": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				Description: "The RFC 5280 revocation reason code, e.g. 0 (unspecified), 1 (key compromise), 4 (superseded) " +
+					"or 5 (cessation of operation). Default: 0.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"revocation_time": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time at which the certificate was revoked.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FabricCertificateRevocationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FabricCertificateRevocationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.SerialNumber.IsUnknown() || data.CertificatePEM.IsUnknown() {
+		return
+	}
+
+	if data.SerialNumber.IsNull() == data.CertificatePEM.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Certificate Reference",
+			"Exactly one of serial_number and certificate_pem must be set.",
+		)
+		return
+	}
+
+	if !data.CertificatePEM.IsNull() {
+		if _, err := certificateSerialNumber(data.CertificatePEM.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("certificate_pem"), "Invalid Certificate", err.Error())
+		}
+	}
+}
+
+func (r *FabricCertificateRevocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FabricCertificateRevocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FabricCertificateRevocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrganizationID.ValueInt64()
+	reason := data.RevocationReason.ValueInt64()
+
+	var serial string
+	if !data.CertificatePEM.IsNull() {
+		var err error
+		serial, err = certificateSerialNumber(data.CertificatePEM.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("certificate_pem"), "Invalid Certificate", err.Error())
+			return
+		}
+
+		params := organizations.NewRevokeCertificateByPEMParamsWithContext(ctx).
+			WithID(orgID).
+			WithRequest(&models.HandlerRevokeCertificateByPEMRequest{
+				Certificate:      data.CertificatePEM.ValueString(),
+				RevocationReason: reason,
+			})
+		if _, err := r.client.API.Organizations.RevokeCertificateByPEM(params, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke certificate, got error: %s", err))
+			return
+		}
+	} else {
+		serial = data.SerialNumber.ValueString()
+
+		params := organizations.NewRevokeCertificateBySerialParamsWithContext(ctx).
+			WithID(orgID).
+			WithRequest(&models.HandlerRevokeCertificateBySerialRequest{
+				SerialNumber:     serial,
+				RevocationReason: reason,
+			})
+		if _, err := r.client.API.Organizations.RevokeCertificateBySerial(params, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke certificate, got error: %s", err))
+			return
+		}
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%d:%s", orgID, serial))
+	data.SerialNumber = types.StringValue(serial)
+	data.RevocationTime = types.StringNull()
+
+	revoked, err := r.findRevokedCertificate(ctx, orgID, serial)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read revoked certificates, got error: %s", err))
+		return
+	}
+	if revoked != nil {
+		data.RevocationTime = stringValueOrNull(revoked.RevocationTime)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricCertificateRevocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FabricCertificateRevocationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	revoked, err := r.findRevokedCertificate(ctx, data.OrganizationID.ValueInt64(), data.SerialNumber.ValueString())
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read revoked certificates, got error: %s", err))
+		return
+	}
+
+	// The certificate was un-revoked outside Terraform
+	if revoked == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.RevocationReason = types.Int64Value(revoked.Reason)
+	data.RevocationTime = stringValueOrNull(revoked.RevocationTime)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricCertificateRevocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute forces a replacement, so there is nothing to send here.
+	var data FabricCertificateRevocationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricCertificateRevocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FabricCertificateRevocationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := organizations.NewDeleteRevokedCertificateParamsWithContext(ctx).
+		WithID(data.OrganizationID.ValueInt64()).
+		WithRequest(&models.HandlerDeleteRevokedCertificateRequest{
+			SerialNumber: data.SerialNumber.ValueString(),
+		})
+	if _, err := r.client.API.Organizations.DeleteRevokedCertificate(params, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to un-revoke certificate, got error: %s", err))
+		return
+	}
+}
+
+func (r *FabricCertificateRevocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgIDPart, serial, ok := strings.Cut(req.ID, ":")
+	orgID, err := strconv.ParseInt(orgIDPart, 10, 64)
+	if !ok || err != nil || serial == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected an import ID of the form organization_id:serial_number, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("serial_number"), serial)...)
+}
+
+// findRevokedCertificate returns the revocation entry for serial in the CRL of
+// an organization, or nil when the certificate is not revoked.
+func (r *FabricCertificateRevocationResource) findRevokedCertificate(ctx context.Context, orgID int64, serial string) (*models.HandlerRevokedCertificateResponse, error) {
+	list, err := r.client.API.Organizations.GetRevokedCertificates(organizations.NewGetRevokedCertificatesParamsWithContext(ctx).WithID(orgID), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, revoked := range list.Payload {
+		if revoked != nil && sameSerialNumber(revoked.SerialNumber, serial) {
+			return revoked, nil
+		}
+	}
+	return nil, nil
+}
+
+// certificateSerialNumber returns the serial number of a PEM encoded certificate as a hex string.
+func certificateSerialNumber(certPEM string) (string, error) {
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return "", err
+	}
+	return cert.SerialNumber.Text(16), nil
+}
+
+// parseCertificatePEM parses the first certificate of a PEM document.
+func parseCertificatePEM(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certPEM)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("the value is not a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// normalizeSerialNumber brings a hex serial number to lower case without
+// colons, an 0x prefix or leading zeros, so that formats can be compared.
+func normalizeSerialNumber(serial string) string {
+	serial = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(serial), ":", ""))
+	serial = strings.TrimLeft(strings.TrimPrefix(serial, "0x"), "0")
+	if serial == "" {
+		return "0"
+	}
+	return serial
+}
+
+// sameSerialNumber reports whether two hex serial numbers are equal.
+func sameSerialNumber(a, b string) bool {
+	return normalizeSerialNumber(a) == normalizeSerialNumber(b)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestSameSerialNumber(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1a2b", "1A2B", true},
+		{"00:1a:2b", "1a2b", true},
+		{"0x1a2b", "001A2B", true},
+		{"0", "00", true},
+		{"1a2b", "1a2c", false},
+	}
+	for _, tt := range tests {
+		if got := sameSerialNumber(tt.a, tt.b); got != tt.want {
+			t.Errorf("sameSerialNumber(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if !sameSerialNumberSet([]string{"0A", "1b"}, []string{"1B", "a"}) {
+		t.Error("expected sets with the same serial numbers in another order and format to be equal")
+	}
+	if sameSerialNumberSet([]string{"a"}, []string{"a", "b"}) || sameSerialNumberSet([]string{"a", "a"}, []string{"a", "b"}) {
+		t.Error("expected sets with different serial numbers to differ")
+	}
+}
+
+func TestCertificateSerialNumber(t *testing.T) {
+	certPEM, _, cert := newClientCertificate(t)
+
+	serial, err := certificateSerialNumber("\n" + certPEM)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if serial != cert.SerialNumber.Text(16) {
+		t.Errorf("expected serial number %s, got %s", cert.SerialNumber.Text(16), serial)
+	}
+
+	if _, err := certificateSerialNumber("not a certificate"); err == nil {
+		t.Error("expected an error for input that is not a certificate")
+	}
+}
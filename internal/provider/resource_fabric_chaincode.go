@@ -2,8 +2,8 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,6 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/smart_contracts"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricChaincodeResource{}
@@ -107,34 +111,21 @@ func (r *FabricChaincodeResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Create chaincode request
-	createReq := struct {
-		Name      string `json:"name"`
-		NetworkID int64  `json:"network_id"`
-	}{
+	params := chaincode.NewCreateChaincodeParamsWithContext(ctx).WithRequest(&models.ChainlaunchdeployCreateChaincodeRequest{
 		Name:      data.Name.ValueString(),
 		NetworkID: data.NetworkID.ValueInt64(),
-	}
+	})
 
-	body, err := r.client.DoRequest("POST", "/sc/fabric/chaincodes", createReq)
+	created, err := r.client.API.Chaincode.CreateChaincode(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create chaincode: %s", err))
 		return
 	}
-
-	var createResp struct {
-		Chaincode struct {
-			ID              int64  `json:"id"`
-			Name            string `json:"name"`
-			NetworkID       int64  `json:"network_id"`
-			NetworkName     string `json:"network_name"`
-			NetworkPlatform string `json:"network_platform"`
-			CreatedAt       string `json:"created_at"`
-		} `json:"chaincode"`
-	}
-	if err := json.Unmarshal(body, &createResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	if created.Payload.Chaincode == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create chaincode: the response did not include the chaincode")
 		return
 	}
+	createResp := created.Payload
 
 	// Set state
 	data.ID = types.Int64Value(createResp.Chaincode.ID)
@@ -155,10 +146,24 @@ func (r *FabricChaincodeResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	// The API doesn't have a GET /sc/fabric/chaincodes/{id} endpoint
-	// We would need to list all chaincodes and find ours
-	// For now, we'll keep the state as-is (optimistic approach)
-	// In a production implementation, you might want to call LIST and verify existence
+	params := smart_contracts.NewGetFabricChaincodeDetailParamsWithContext(ctx).WithID(strconv.FormatInt(data.ID.ValueInt64(), 10))
+	detail, err := r.client.API.SmartContracts.GetFabricChaincodeDetail(params, nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read chaincode: %s", err))
+		return
+	}
+	chaincodeResp := detail.Payload
+
+	if chaincodeResp.NetworkName != "" {
+		data.NetworkName = types.StringValue(chaincodeResp.NetworkName)
+	}
+	if chaincodeResp.NetworkPlatform != "" {
+		data.NetworkPlatform = types.StringValue(chaincodeResp.NetworkPlatform)
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -198,8 +203,8 @@ func (r *FabricChaincodeResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Delete the chaincode
-	endpoint := fmt.Sprintf("/sc/fabric/chaincodes/%d", data.ID.ValueInt64())
-	_, err := r.client.DoRequest("DELETE", endpoint, nil)
+	params := chaincode.NewDeleteChaincodeParamsWithContext(ctx).WithID(strconv.FormatInt(data.ID.ValueInt64(), 10))
+	_, err := r.client.API.Chaincode.DeleteChaincode(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete chaincode: %s", err))
 		return
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -25,11 +26,12 @@ type FabricChaincodeApproveResource struct {
 }
 
 type FabricChaincodeApproveResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	DefinitionID types.Int64  `tfsdk:"definition_id"`
-	PeerID       types.Int64  `tfsdk:"peer_id"`
-	Status       types.String `tfsdk:"status"`
-	Message      types.String `tfsdk:"message"`
+	ID           types.String   `tfsdk:"id"`
+	DefinitionID types.Int64    `tfsdk:"definition_id"`
+	PeerID       types.Int64    `tfsdk:"peer_id"`
+	Status       types.String   `tfsdk:"status"`
+	Message      types.String   `tfsdk:"message"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FabricChaincodeApproveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,6 +73,12 @@ func (r *FabricChaincodeApproveResource) Schema(ctx context.Context, req resourc
 				Description: "Message from the approval operation.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 	}
 }
 
@@ -99,6 +107,15 @@ func (r *FabricChaincodeApproveResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Approve chaincode request
 	approveReq := struct {
 		PeerID int64 `json:"peer_id"`
@@ -106,8 +123,9 @@ func (r *FabricChaincodeApproveResource) Create(ctx context.Context, req resourc
 		PeerID: data.PeerID.ValueInt64(),
 	}
 
+	// DoRequest rather than c.API: the raw error body is matched below
 	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d/approve", data.DefinitionID.ValueInt64())
-	body, err := r.client.DoRequest("POST", endpoint, approveReq)
+	body, err := r.client.DoRequest(ctx, "POST", endpoint, approveReq)
 	if err != nil {
 		// Check if the error is "attempted to redefine the current committed sequence"
 		// This means the chaincode is already approved at this sequence
@@ -175,6 +193,15 @@ func (r *FabricChaincodeApproveResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Re-approve for updates
 	approveReq := struct {
 		PeerID int64 `json:"peer_id"`
@@ -183,7 +210,7 @@ func (r *FabricChaincodeApproveResource) Update(ctx context.Context, req resourc
 	}
 
 	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d/approve", data.DefinitionID.ValueInt64())
-	body, err := r.client.DoRequest("POST", endpoint, approveReq)
+	body, err := r.client.DoRequest(ctx, "POST", endpoint, approveReq)
 	if err != nil {
 		// Check if the error is "attempted to redefine the current committed sequence"
 		errStr := err.Error()
@@ -2,18 +2,28 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/smart_contracts"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricChaincodeDefinitionResource{}
+var _ resource.ResourceWithModifyPlan = &FabricChaincodeDefinitionResource{}
+var _ resource.ResourceWithValidateConfig = &FabricChaincodeDefinitionResource{}
 
 func NewFabricChaincodeDefinitionResource() resource.Resource {
 	return &FabricChaincodeDefinitionResource{}
@@ -24,14 +34,17 @@ type FabricChaincodeDefinitionResource struct {
 }
 
 type FabricChaincodeDefinitionResourceModel struct {
-	ID                types.Int64  `tfsdk:"id"`
-	ChaincodeID       types.Int64  `tfsdk:"chaincode_id"`
-	Version           types.String `tfsdk:"version"`
-	Sequence          types.Int64  `tfsdk:"sequence"`
-	DockerImage       types.String `tfsdk:"docker_image"`
-	EndorsementPolicy types.String `tfsdk:"endorsement_policy"`
-	ChaincodeAddress  types.String `tfsdk:"chaincode_address"`
-	CreatedAt         types.String `tfsdk:"created_at"`
+	ID                types.Int64    `tfsdk:"id"`
+	ChaincodeID       types.Int64    `tfsdk:"chaincode_id"`
+	Version           types.String   `tfsdk:"version"`
+	Sequence          types.Int64    `tfsdk:"sequence"`
+	DockerImage       types.String   `tfsdk:"docker_image"`
+	EndorsementPolicy types.String   `tfsdk:"endorsement_policy"`
+	ChaincodeAddress  types.String   `tfsdk:"chaincode_address"`
+	PeerID            types.Int64    `tfsdk:"peer_id"`
+	ChangedFields     types.List     `tfsdk:"changed_fields"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FabricChaincodeDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -42,7 +55,7 @@ func (r *FabricChaincodeDefinitionResource) Schema(ctx context.Context, req reso
 	resp.Schema = schema.Schema{
 		Description: "Manages a Fabric chaincode definition. A chaincode can have multiple definitions with different versions and sequences. " +
 			"Definitions are immutable - any changes will create a new definition and destroy the old one. " +
-			"To upgrade chaincode, create a new definition resource with an incremented sequence number.",
+			"To upgrade chaincode, change the version, image, policy or address: when sequence is not set, the next sequence is computed from the definition committed on the channel.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
@@ -67,9 +80,12 @@ func (r *FabricChaincodeDefinitionResource) Schema(ctx context.Context, req reso
 				},
 			},
 			"sequence": schema.Int64Attribute{
-				Required:    true,
-				Description: "The sequence number for this definition. Must be incremented for each new definition on the same channel. Changes require replacement.",
+				Optional: true,
+				Computed: true,
+				Description: "The sequence number for this definition. Must be incremented for each new definition on the same channel. " +
+					"When not set, it is computed at plan time through peer_id: the committed sequence if the definition matches the committed one, the next sequence otherwise. Changes require replacement.",
 				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
 				},
 			},
@@ -94,6 +110,18 @@ func (r *FabricChaincodeDefinitionResource) Schema(ctx context.Context, req reso
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"peer_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of a peer joined to the channel, queried for the committed definition when sequence is not set.",
+			},
+			"changed_fields": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The fields (version, docker_image, endorsement_policy, chaincode_address) that differ from the definition committed on the channel, set when the sequence is computed.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the definition was created.",
@@ -102,9 +130,85 @@ func (r *FabricChaincodeDefinitionResource) Schema(ctx context.Context, req reso
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
+func (r *FabricChaincodeDefinitionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FabricChaincodeDefinitionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Sequence.IsNull() && data.PeerID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("sequence"), "Missing Sequence", "Set sequence, or peer_id so that the sequence can be computed from the channel.")
+	}
+}
+
+// ModifyPlan computes the sequence of a new definition when it is not
+// configured, so that the plan shows it along with the fields that changed
+// since the committed definition.
+func (r *FabricChaincodeDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compute on destroy
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan FabricChaincodeDefinitionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only a new definition gets a sequence; in-place changes keep it
+	if !req.State.Raw.IsNull() {
+		var state FabricChaincodeDefinitionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ChaincodeID.Equal(state.ChaincodeID) && len(definitionChanges(&models.ChainlaunchdeployChaincodeDefinitionResponse{
+			Version:           state.Version.ValueString(),
+			DockerImage:       state.DockerImage.ValueString(),
+			EndorsementPolicy: state.EndorsementPolicy.ValueString(),
+			ChaincodeAddress:  state.ChaincodeAddress.ValueString(),
+		}, &plan)) == 0 {
+			return
+		}
+	}
+
+	var sequence types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sequence"), &sequence)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Sequence = sequence
+	plan.ChangedFields = types.ListNull(types.StringType)
+	if sequence.IsNull() {
+		plan.Sequence = types.Int64Unknown()
+		plan.ChangedFields = types.ListUnknown(types.StringType)
+		// Values only known after apply leave the sequence to Create
+		if !plan.ChaincodeID.IsUnknown() && !plan.PeerID.IsUnknown() && !plan.Version.IsUnknown() && !plan.DockerImage.IsUnknown() &&
+			!plan.EndorsementPolicy.IsUnknown() && !plan.ChaincodeAddress.IsUnknown() {
+			resp.Diagnostics.Append(r.computeSequence(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sequence"), plan.Sequence)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("changed_fields"), plan.ChangedFields)...)
+}
+
 func (r *FabricChaincodeDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -130,46 +234,47 @@ func (r *FabricChaincodeDefinitionResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	// Create definition request
-	createReq := struct {
-		ChaincodeID       int64  `json:"chaincode_id"`
-		Version           string `json:"version"`
-		Sequence          int64  `json:"sequence"`
-		DockerImage       string `json:"docker_image"`
-		EndorsementPolicy string `json:"endorsement_policy,omitempty"`
-		ChaincodeAddress  string `json:"chaincode_address,omitempty"`
-	}{
-		ChaincodeID:       data.ChaincodeID.ValueInt64(),
-		Version:           data.Version.ValueString(),
-		Sequence:          data.Sequence.ValueInt64(),
-		DockerImage:       data.DockerImage.ValueString(),
-		EndorsementPolicy: data.EndorsementPolicy.ValueString(),
-		ChaincodeAddress:  data.ChaincodeAddress.ValueString(),
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if data.Sequence.IsUnknown() {
+		resp.Diagnostics.Append(r.computeSequence(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+	if data.ChangedFields.IsUnknown() {
+		data.ChangedFields = types.ListNull(types.StringType)
+	}
+
+	// Create definition request
+	params := chaincode.NewCreateChaincodeDefinitionParamsWithContext(ctx).
+		WithChaincodeID(data.ChaincodeID.ValueInt64()).
+		WithRequest(&models.ChainlaunchdeployCreateChaincodeDefinitionRequest{
+			ChaincodeID:       data.ChaincodeID.ValueInt64(),
+			Version:           data.Version.ValueString(),
+			Sequence:          data.Sequence.ValueInt64(),
+			DockerImage:       data.DockerImage.ValueString(),
+			EndorsementPolicy: data.EndorsementPolicy.ValueString(),
+			ChaincodeAddress:  data.ChaincodeAddress.ValueString(),
+		})
 
-	endpoint := fmt.Sprintf("/sc/fabric/chaincodes/%d/definitions", data.ChaincodeID.ValueInt64())
-	body, err := r.client.DoRequest("POST", endpoint, createReq)
+	created, err := r.client.API.Chaincode.CreateChaincodeDefinition(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create chaincode definition: %s", err))
 		return
 	}
-
-	var createResp struct {
-		Definition struct {
-			ID                int64  `json:"id"`
-			ChaincodeID       int64  `json:"chaincode_id"`
-			Version           string `json:"version"`
-			Sequence          int64  `json:"sequence"`
-			DockerImage       string `json:"docker_image"`
-			EndorsementPolicy string `json:"endorsement_policy"`
-			ChaincodeAddress  string `json:"chaincode_address"`
-			CreatedAt         string `json:"created_at"`
-		} `json:"definition"`
-	}
-	if err := json.Unmarshal(body, &createResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	if created.Payload.Definition == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to create chaincode definition: the response did not include the definition")
 		return
 	}
+	createResp := created.Payload
 
 	// Set state
 	data.ID = types.Int64Value(createResp.Definition.ID)
@@ -193,29 +298,23 @@ func (r *FabricChaincodeDefinitionResource) Read(ctx context.Context, req resour
 	}
 
 	// Get definition details
-	endpoint := fmt.Sprintf("/sc/fabric/chaincodes/%d/definitions/%d", data.ChaincodeID.ValueInt64(), data.ID.ValueInt64())
-	body, err := r.client.DoRequest("GET", endpoint, nil)
+	params := chaincode.NewGetChaincodeDefinitionDetailParamsWithContext(ctx).
+		WithChaincodeID(data.ChaincodeID.ValueInt64()).
+		WithDefinitionID(data.ID.ValueInt64())
+	detail, err := r.client.API.Chaincode.GetChaincodeDefinitionDetail(params, nil)
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read chaincode definition: %s", err))
 		return
 	}
-
-	var readResp struct {
-		Definition struct {
-			ID                int64  `json:"id"`
-			ChaincodeID       int64  `json:"chaincode_id"`
-			Version           string `json:"version"`
-			Sequence          int64  `json:"sequence"`
-			DockerImage       string `json:"docker_image"`
-			EndorsementPolicy string `json:"endorsement_policy"`
-			ChaincodeAddress  string `json:"chaincode_address"`
-			CreatedAt         string `json:"created_at"`
-		} `json:"definition"`
-	}
-	if err := json.Unmarshal(body, &readResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
+	if detail.Payload.Definition == nil {
+		resp.State.RemoveResource(ctx)
 		return
 	}
+	readResp := detail.Payload
 
 	// Update state
 	data.ID = types.Int64Value(readResp.Definition.ID)
@@ -231,13 +330,16 @@ func (r *FabricChaincodeDefinitionResource) Read(ctx context.Context, req resour
 }
 
 func (r *FabricChaincodeDefinitionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All fields are marked as RequiresReplace, so this method should never be called
-	// Chaincode definitions are immutable in Fabric - any change requires creating a new definition
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"Chaincode definitions are immutable and cannot be updated. Any changes require creating a new definition resource with an incremented sequence number. "+
-			"This error should not occur as all fields are marked with RequiresReplace.",
-	)
+	var data FabricChaincodeDefinitionResourceModel
+
+	// Chaincode definitions are immutable in Fabric - every definition attribute is marked
+	// with RequiresReplace, so the only in-place changes are to peer_id and the timeouts block
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *FabricChaincodeDefinitionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -248,11 +350,105 @@ func (r *FabricChaincodeDefinitionResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the definition
-	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d", data.ID.ValueInt64())
-	_, err := r.client.DoRequest("DELETE", endpoint, nil)
+	params := chaincode.NewDeleteChaincodeDefinitionParamsWithContext(ctx).WithDefinitionID(data.ID.ValueInt64())
+	_, err := r.client.API.Chaincode.DeleteChaincodeDefinition(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete chaincode definition: %s", err))
 		return
 	}
 }
+
+// computeSequence sets the sequence of data from the definition committed on
+// the channel, as seen by peer_id: the committed sequence when data matches
+// the committed definition, the next one otherwise.
+func (r *FabricChaincodeDefinitionResource) computeSequence(ctx context.Context, data *FabricChaincodeDefinitionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.PeerID.IsNull() {
+		diags.AddAttributeError(path.Root("peer_id"), "Missing Peer", "peer_id is required to compute the sequence when sequence is not set.")
+		return diags
+	}
+
+	detail, err := r.client.API.SmartContracts.GetFabricChaincodeDetail(smart_contracts.NewGetFabricChaincodeDetailParamsWithContext(ctx).WithID(strconv.FormatInt(data.ChaincodeID.ValueInt64(), 10)), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read chaincode, got error: %s", err))
+		return diags
+	}
+	name := detail.Payload.Name
+	// Fabric networks are named after their channel
+	channel := detail.Payload.NetworkName
+
+	params := smart_contracts.NewGetFabricChaincodeSequenceParamsWithContext(ctx).
+		WithPeerID(strconv.FormatInt(data.PeerID.ValueInt64(), 10)).
+		WithChaincodeName(name).
+		WithChannelName(channel)
+	committed, err := r.client.API.SmartContracts.GetFabricChaincodeSequence(params, nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read the committed sequence of chaincode %s on channel %s, got error: %s", name, channel, err))
+		return diags
+	}
+	current := committed.Payload.Sequence
+
+	changed := []string{}
+	if current > 0 {
+		definitions, err := r.client.API.Chaincode.ListChaincodeDefinitions(chaincode.NewListChaincodeDefinitionsParamsWithContext(ctx).WithChaincodeID(data.ChaincodeID.ValueInt64()), nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to list chaincode definitions, got error: %s", err))
+			return diags
+		}
+
+		var committedDefinition *models.ChainlaunchdeployChaincodeDefinitionResponse
+		for _, definition := range definitions.Payload.Definitions {
+			if definition != nil && definition.Sequence == current {
+				committedDefinition = definition
+				break
+			}
+		}
+
+		if committedDefinition == nil {
+			diags.AddWarning("Unknown Committed Definition", fmt.Sprintf(
+				"The definition of chaincode %s committed at sequence %d on channel %s was not created through Chainlaunch and cannot be compared, so sequence %d is used.",
+				name, current, channel, current+1))
+			current++
+		} else if changed = definitionChanges(committedDefinition, data); len(changed) > 0 {
+			current++
+		}
+	} else {
+		current = 1
+	}
+
+	data.Sequence = types.Int64Value(current)
+	var listDiags diag.Diagnostics
+	data.ChangedFields, listDiags = types.ListValueFrom(ctx, types.StringType, changed)
+	diags.Append(listDiags...)
+	return diags
+}
+
+// definitionChanges returns the attributes of data that differ from the
+// committed definition, in schema order.
+func definitionChanges(committed *models.ChainlaunchdeployChaincodeDefinitionResponse, data *FabricChaincodeDefinitionResourceModel) []string {
+	changed := []string{}
+	if data.Version.ValueString() != committed.Version {
+		changed = append(changed, "version")
+	}
+	if data.DockerImage.ValueString() != committed.DockerImage {
+		changed = append(changed, "docker_image")
+	}
+	if data.EndorsementPolicy.ValueString() != committed.EndorsementPolicy {
+		changed = append(changed, "endorsement_policy")
+	}
+	if data.ChaincodeAddress.ValueString() != committed.ChaincodeAddress {
+		changed = append(changed, "chaincode_address")
+	}
+	return changed
+}
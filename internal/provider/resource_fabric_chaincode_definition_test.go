@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+func TestDefinitionChanges(t *testing.T) {
+	committed := &models.ChainlaunchdeployChaincodeDefinitionResponse{
+		Version:           "1.0",
+		DockerImage:       "example/basic:1.0",
+		EndorsementPolicy: "OR('Org1MSP.member')",
+		ChaincodeAddress:  "basic:7052",
+	}
+
+	tests := []struct {
+		name string
+		data FabricChaincodeDefinitionResourceModel
+		want []string
+	}{
+		{
+			name: "unchanged",
+			data: FabricChaincodeDefinitionResourceModel{
+				Version:           types.StringValue("1.0"),
+				DockerImage:       types.StringValue("example/basic:1.0"),
+				EndorsementPolicy: types.StringValue("OR('Org1MSP.member')"),
+				ChaincodeAddress:  types.StringValue("basic:7052"),
+			},
+			want: []string{},
+		},
+		{
+			name: "new version and image",
+			data: FabricChaincodeDefinitionResourceModel{
+				Version:           types.StringValue("2.0"),
+				DockerImage:       types.StringValue("example/basic:2.0"),
+				EndorsementPolicy: types.StringValue("OR('Org1MSP.member')"),
+				ChaincodeAddress:  types.StringValue("basic:7052"),
+			},
+			want: []string{"version", "docker_image"},
+		},
+		{
+			name: "policy removed",
+			data: FabricChaincodeDefinitionResourceModel{
+				Version:           types.StringValue("1.0"),
+				DockerImage:       types.StringValue("example/basic:1.0"),
+				EndorsementPolicy: types.StringNull(),
+				ChaincodeAddress:  types.StringValue("basic:7053"),
+			},
+			want: []string{"endorsement_policy", "chaincode_address"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := definitionChanges(committed, &tt.data); !slices.Equal(got, tt.want) {
+				t.Errorf("definitionChanges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFabricChaincodeDefinitionComputeSequence(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		sequence    string
+		version     string
+		wantSeq     int64
+		wantChanged []string
+	}{
+		{name: "nothing committed", sequence: `{"sequence":0}`, version: "1.0", wantSeq: 1, wantChanged: []string{}},
+		{name: "committed definition matches", sequence: `{"sequence":2}`, version: "1.0", wantSeq: 2, wantChanged: []string{}},
+		{name: "new version", sequence: `{"sequence":2}`, version: "2.0", wantSeq: 3, wantChanged: []string{"version"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			responses := map[string]string{
+				"/api/v1/sc/fabric/chaincodes/7":              `{"id":7,"name":"basic","network_name":"mychannel"}`,
+				"/api/v1/sc/fabric/peer/5/chaincode/sequence": tt.sequence,
+				"/api/v1/sc/fabric/chaincodes/7/definitions":  `{"definitions":[{"id":8,"chaincode_id":7,"version":"1.0","sequence":2,"docker_image":"example/basic:1.0","chaincode_address":"basic:7052"}]}`,
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/api/v1/sc/fabric/peer/5/chaincode/sequence" &&
+					(r.URL.Query().Get("chaincodeName") != "basic" || r.URL.Query().Get("channelName") != "mychannel") {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				body, ok := responses[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"error":"not found"}`))
+					return
+				}
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			r := &FabricChaincodeDefinitionResource{client: NewClient(server.URL, "", "test", "test")}
+			data := FabricChaincodeDefinitionResourceModel{
+				ChaincodeID:       types.Int64Value(7),
+				PeerID:            types.Int64Value(5),
+				Version:           types.StringValue(tt.version),
+				DockerImage:       types.StringValue("example/basic:1.0"),
+				EndorsementPolicy: types.StringNull(),
+				ChaincodeAddress:  types.StringValue("basic:7052"),
+			}
+
+			if diags := r.computeSequence(ctx, &data); diags.HasError() {
+				t.Fatalf("computeSequence returned errors: %v", diags)
+			}
+
+			if got := data.Sequence.ValueInt64(); got != tt.wantSeq {
+				t.Errorf("sequence = %d, want %d", got, tt.wantSeq)
+			}
+			var changed []string
+			data.ChangedFields.ElementsAs(ctx, &changed, false)
+			if !slices.Equal(changed, tt.wantChanged) {
+				t.Errorf("changed_fields = %v, want %v", changed, tt.wantChanged)
+			}
+		})
+	}
+}
@@ -2,9 +2,9 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -12,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricChaincodeDeployResource{}
@@ -25,11 +28,12 @@ type FabricChaincodeDeployResource struct {
 }
 
 type FabricChaincodeDeployResourceModel struct {
-	ID                   types.String `tfsdk:"id"`
-	DefinitionID         types.Int64  `tfsdk:"definition_id"`
-	EnvironmentVariables types.Map    `tfsdk:"environment_variables"`
-	Status               types.String `tfsdk:"status"`
-	Message              types.String `tfsdk:"message"`
+	ID                   types.String   `tfsdk:"id"`
+	DefinitionID         types.Int64    `tfsdk:"definition_id"`
+	EnvironmentVariables types.Map      `tfsdk:"environment_variables"`
+	Status               types.String   `tfsdk:"status"`
+	Message              types.String   `tfsdk:"message"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FabricChaincodeDeployResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,12 +70,24 @@ func (r *FabricChaincodeDeployResource) Schema(ctx context.Context, req resource
 			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "The status of the deployment operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"message": schema.StringAttribute{
 				Computed:    true,
 				Description: "Message from the deployment operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -100,6 +116,15 @@ func (r *FabricChaincodeDeployResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Prepare environment variables
 	var envVars map[string]string
 	if !data.EnvironmentVariables.IsNull() {
@@ -110,25 +135,18 @@ func (r *FabricChaincodeDeployResource) Create(ctx context.Context, req resource
 	}
 
 	// Deploy chaincode request
-	deployReq := struct {
-		EnvironmentVariables map[string]string `json:"environment_variables,omitempty"`
-	}{
-		EnvironmentVariables: envVars,
-	}
+	params := chaincode.NewDeployChaincodeByDefinitionParamsWithContext(ctx).
+		WithDefinitionID(data.DefinitionID.ValueInt64()).
+		WithRequest(&models.ChainlaunchdeployDeployChaincodeByDefinitionRequest{
+			EnvironmentVariables: envVars,
+		})
 
-	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d/deploy", data.DefinitionID.ValueInt64())
-	body, err := r.client.DoRequest("POST", endpoint, deployReq)
+	deployed, err := r.client.API.Chaincode.DeployChaincodeByDefinition(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy chaincode: %s", err))
 		return
 	}
-
-	// Parse response (it returns a map[string]string)
-	var deployResp map[string]string
-	if err := json.Unmarshal(body, &deployResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse response: %s", err))
-		return
-	}
+	deployResp := deployed.Payload
 
 	// Set state
 	data.ID = types.StringValue(fmt.Sprintf("%d", data.DefinitionID.ValueInt64()))
@@ -172,8 +190,8 @@ func (r *FabricChaincodeDeployResource) Update(ctx context.Context, req resource
 		return
 	}
 
-	// All fields are marked as RequiresReplace, so this shouldn't be called
-	// Including for consistency
+	// All other fields are marked as RequiresReplace, so only the timeouts block
+	// can change in place
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -186,9 +204,18 @@ func (r *FabricChaincodeDeployResource) Delete(ctx context.Context, req resource
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Undeploy the chaincode (stop the Docker container)
-	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d/undeploy", data.DefinitionID.ValueInt64())
-	_, err := r.client.DoRequest("POST", endpoint, nil)
+	params := chaincode.NewRemoveChaincodeDeploymentParamsWithContext(ctx).WithDefinitionID(data.DefinitionID.ValueInt64())
+	_, err := r.client.API.Chaincode.RemoveChaincodeDeployment(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Undeploy Warning", fmt.Sprintf("Unable to undeploy chaincode: %s. The container may still be running.", err))
 		// Continue with state removal even if undeploy fails
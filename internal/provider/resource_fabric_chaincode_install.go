@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -26,11 +27,12 @@ type FabricChaincodeInstallResource struct {
 }
 
 type FabricChaincodeInstallResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	DefinitionID types.Int64  `tfsdk:"definition_id"`
-	PeerIDs      types.List   `tfsdk:"peer_ids"`
-	Status       types.String `tfsdk:"status"`
-	Message      types.String `tfsdk:"message"`
+	ID           types.String   `tfsdk:"id"`
+	DefinitionID types.Int64    `tfsdk:"definition_id"`
+	PeerIDs      types.List     `tfsdk:"peer_ids"`
+	Status       types.String   `tfsdk:"status"`
+	Message      types.String   `tfsdk:"message"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FabricChaincodeInstallResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -67,12 +69,23 @@ func (r *FabricChaincodeInstallResource) Schema(ctx context.Context, req resourc
 			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "The status of the install operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"message": schema.StringAttribute{
 				Computed:    true,
 				Description: "Message from the install operation.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
@@ -101,6 +114,15 @@ func (r *FabricChaincodeInstallResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Convert peer_ids list to slice
 	var peerIDs []int64
 	resp.Diagnostics.Append(data.PeerIDs.ElementsAs(ctx, &peerIDs, false)...)
@@ -115,8 +137,9 @@ func (r *FabricChaincodeInstallResource) Create(ctx context.Context, req resourc
 		PeerIDs: peerIDs,
 	}
 
+	// DoRequest rather than c.API: the raw error body is matched below
 	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d/install", data.DefinitionID.ValueInt64())
-	body, err := r.client.DoRequest("POST", endpoint, installReq)
+	body, err := r.client.DoRequest(ctx, "POST", endpoint, installReq)
 	if err != nil {
 		// Check if the error is "already installed" which is not actually an error
 		errStr := err.Error()
@@ -186,8 +209,8 @@ func (r *FabricChaincodeInstallResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	// All fields are marked as RequiresReplace, so this shouldn't be called
-	// Including for consistency
+	// All other fields are marked as RequiresReplace, so only the timeouts block
+	// can change in place
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
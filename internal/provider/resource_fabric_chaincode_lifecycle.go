@@ -0,0 +1,602 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/chaincode"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/smart_contracts"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &FabricChaincodeLifecycleResource{}
+var _ resource.ResourceWithValidateConfig = &FabricChaincodeLifecycleResource{}
+
+func NewFabricChaincodeLifecycleResource() resource.Resource {
+	return &FabricChaincodeLifecycleResource{}
+}
+
+// FabricChaincodeLifecycleResource drives a chaincode definition through
+// install, approve, commit and deploy, and reads the committed definition
+// back from every peer so that a peer falling out of sync shows up as drift.
+type FabricChaincodeLifecycleResource struct {
+	client *Client
+}
+
+type FabricChaincodeLifecycleResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	ChaincodeID          types.Int64    `tfsdk:"chaincode_id"`
+	DefinitionID         types.Int64    `tfsdk:"definition_id"`
+	InstallPeerIDs       types.Set      `tfsdk:"install_peer_ids"`
+	ApprovePeerIDs       types.Set      `tfsdk:"approve_peer_ids"`
+	CommitPeerID         types.Int64    `tfsdk:"commit_peer_id"`
+	Deploy               types.Bool     `tfsdk:"deploy"`
+	EnvironmentVariables types.Map      `tfsdk:"environment_variables"`
+	Name                 types.String   `tfsdk:"name"`
+	ChannelName          types.String   `tfsdk:"channel_name"`
+	Version              types.String   `tfsdk:"version"`
+	Sequence             types.Int64    `tfsdk:"sequence"`
+	Peers                types.List     `tfsdk:"peers"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// FabricChaincodeLifecyclePeerModel is the committed definition one peer
+// reports for the chaincode.
+type FabricChaincodeLifecyclePeerModel struct {
+	PeerID   types.Int64  `tfsdk:"peer_id"`
+	InSync   types.Bool   `tfsdk:"in_sync"`
+	Version  types.String `tfsdk:"committed_version"`
+	Sequence types.Int64  `tfsdk:"committed_sequence"`
+}
+
+// chaincodeLifecyclePeerAttrTypes describes the elements of the peers list.
+var chaincodeLifecyclePeerAttrTypes = map[string]attr.Type{
+	"peer_id":            types.Int64Type,
+	"in_sync":            types.BoolType,
+	"committed_version":  types.StringType,
+	"committed_sequence": types.Int64Type,
+}
+
+func (r *FabricChaincodeLifecycleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_chaincode_lifecycle"
+}
+
+func (r *FabricChaincodeLifecycleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs the whole Fabric chaincode lifecycle for a chaincode definition: installs it on peers, approves it for every organization, commits it to the channel and deploys the chaincode container. " +
+			"It replaces chainlaunch_fabric_chaincode_install, _approve, _commit and _deploy. " +
+			"On refresh every peer is asked for the definitions committed on the channel; an approve or commit peer that no longer reports this definition's sequence (or a later one) is dropped from state, so the next apply approves or commits with it again. " +
+			"Install peers are not checked this way, since the committed definition does not tell whether a peer still has the package installed. " +
+			"Note: Fabric cannot uninstall, unapprove or uncommit a chaincode, so deleting this resource only stops the chaincode container.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the lifecycle (format: definition_id).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"chaincode_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the chaincode the definition belongs to.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"definition_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the chaincode definition to run through the lifecycle. Upgrade by pointing it at a definition with a higher sequence.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"install_peer_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the peers to install the chaincode on. Adding a peer installs it there; removing one leaves the chaincode installed.",
+			},
+			"approve_peer_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the peers that approve the definition, one per organization. Adding a peer approves for its organization; removing one keeps the approval.",
+			},
+			"commit_peer_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the peer that commits the definition to the channel once enough organizations approved it.",
+			},
+			"deploy": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to run the chaincode container after the commit. Defaults to true; set it to false for chaincode run outside Chainlaunch.",
+			},
+			"environment_variables": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Environment variables of the chaincode container. Changing them redeploys the container.",
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the chaincode.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The channel the definition is committed to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of the definition.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sequence": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The sequence of the definition.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"peers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The committed definition each install, approve and commit peer reports, ordered by peer ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"peer_id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the peer.",
+						},
+						"in_sync": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the peer reports this definition's sequence, or a later one, as committed.",
+						},
+						"committed_version": schema.StringAttribute{
+							Computed:    true,
+							Description: "The version the peer reports as committed, null when the chaincode is not committed on it.",
+						},
+						"committed_sequence": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The sequence the peer reports as committed, null when the chaincode is not committed on it.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *FabricChaincodeLifecycleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FabricChaincodeLifecycleResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, peerIDs := range map[string]types.Set{
+		"install_peer_ids": data.InstallPeerIDs,
+		"approve_peer_ids": data.ApprovePeerIDs,
+	} {
+		if !peerIDs.IsUnknown() && len(peerIDs.Elements()) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Peers", fmt.Sprintf("%s must list at least one peer.", name))
+		}
+	}
+}
+
+func (r *FabricChaincodeLifecycleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FabricChaincodeLifecycleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FabricChaincodeLifecycleResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	data.ID = types.StringValue(strconv.FormatInt(data.DefinitionID.ValueInt64(), 10))
+
+	// The steps tolerate having already been done, so a failed apply is
+	// picked up again by the next one
+	resp.Diagnostics.Append(r.reconcile(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricChaincodeLifecycleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FabricChaincodeLifecycleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, diags := r.readDefinition(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Deploy.ValueBool() {
+		running, diags := r.containerRunning(ctx, data.DefinitionID.ValueInt64())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// A stopped container plans a redeploy
+		data.Deploy = types.BoolValue(running)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricChaincodeLifecycleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FabricChaincodeLifecycleResourceModel
+	var state FabricChaincodeLifecycleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refresh(ctx, &data, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricChaincodeLifecycleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FabricChaincodeLifecycleResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Deploy.ValueBool() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Installs, approvals and commits cannot be reverted in Fabric; only the
+	// container is stopped
+	params := chaincode.NewRemoveChaincodeDeploymentParamsWithContext(ctx).WithDefinitionID(data.DefinitionID.ValueInt64())
+	_, err := r.client.API.Chaincode.RemoveChaincodeDeployment(params, nil)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Undeploy Warning", fmt.Sprintf("Unable to undeploy chaincode: %s. The container may still be running.", err))
+	}
+}
+
+// reconcile runs the lifecycle steps data needs and state has not done yet.
+// A nil state runs all of them.
+func (r *FabricChaincodeLifecycleResource) reconcile(ctx context.Context, data, state *FabricChaincodeLifecycleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	definitionID := data.DefinitionID.ValueInt64()
+
+	var installPeerIDs, approvePeerIDs, installed, approved []int64
+	diags.Append(data.InstallPeerIDs.ElementsAs(ctx, &installPeerIDs, false)...)
+	diags.Append(data.ApprovePeerIDs.ElementsAs(ctx, &approvePeerIDs, false)...)
+	if state != nil {
+		diags.Append(state.InstallPeerIDs.ElementsAs(ctx, &installed, false)...)
+		diags.Append(state.ApprovePeerIDs.ElementsAs(ctx, &approved, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	slices.Sort(installPeerIDs)
+	slices.Sort(approvePeerIDs)
+
+	// Installing on one peer at a time keeps a peer that already has the
+	// package from failing the install on the others
+	for _, peerID := range installPeerIDs {
+		if slices.Contains(installed, peerID) {
+			continue
+		}
+		err := r.lifecycleStep(ctx, "install", definitionID, map[string]interface{}{"peer_ids": []int64{peerID}}, "chaincode already successfully installed")
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to install chaincode on peer %d, got error: %s", peerID, err))
+			return diags
+		}
+	}
+
+	for _, peerID := range approvePeerIDs {
+		if slices.Contains(approved, peerID) {
+			continue
+		}
+		err := r.lifecycleStep(ctx, "approve", definitionID, map[string]interface{}{"peer_id": peerID},
+			"attempted to redefine the current committed sequence", "unchanged content")
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to approve chaincode with peer %d, got error: %s", peerID, err))
+			return diags
+		}
+	}
+
+	// A committed definition stays committed when the commit peer changes
+	if state == nil || state.CommitPeerID.IsNull() {
+		err := r.lifecycleStep(ctx, "commit", definitionID, map[string]interface{}{"peer_id": data.CommitPeerID.ValueInt64()}, "attempted to redefine the current committed sequence")
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to commit chaincode with peer %d, got error: %s", data.CommitPeerID.ValueInt64(), err))
+			return diags
+		}
+	}
+
+	switch {
+	case data.Deploy.ValueBool() && (state == nil || !state.Deploy.ValueBool() || !state.EnvironmentVariables.Equal(data.EnvironmentVariables)):
+		var envVars map[string]string
+		if !data.EnvironmentVariables.IsNull() {
+			diags.Append(data.EnvironmentVariables.ElementsAs(ctx, &envVars, false)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		params := chaincode.NewDeployChaincodeByDefinitionParamsWithContext(ctx).
+			WithDefinitionID(definitionID).
+			WithRequest(&models.ChainlaunchdeployDeployChaincodeByDefinitionRequest{
+				EnvironmentVariables: envVars,
+			})
+		if _, err := r.client.API.Chaincode.DeployChaincodeByDefinition(params, nil); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to deploy chaincode, got error: %s", err))
+		}
+	case !data.Deploy.ValueBool() && state != nil && state.Deploy.ValueBool():
+		params := chaincode.NewRemoveChaincodeDeploymentParamsWithContext(ctx).WithDefinitionID(definitionID)
+		if _, err := r.client.API.Chaincode.RemoveChaincodeDeployment(params, nil); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to undeploy chaincode, got error: %s", err))
+		}
+	}
+
+	return diags
+}
+
+// lifecycleStep posts one lifecycle step of a definition. Errors containing
+// one of alreadyDone mean the step was done before and are not returned.
+func (r *FabricChaincodeLifecycleResource) lifecycleStep(ctx context.Context, step string, definitionID int64, body interface{}, alreadyDone ...string) error {
+	// DoRequest rather than c.API: the raw error body is matched below
+	endpoint := fmt.Sprintf("/sc/fabric/definitions/%d/%s", definitionID, step)
+	_, err := r.client.DoRequest(ctx, "POST", endpoint, body)
+	if err != nil {
+		for _, msg := range alreadyDone {
+			if strings.Contains(err.Error(), msg) {
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+// readDefinition copies the definition and its chaincode into data. It
+// returns false when either no longer exists.
+func (r *FabricChaincodeLifecycleResource) readDefinition(ctx context.Context, data *FabricChaincodeLifecycleResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := chaincode.NewGetChaincodeDefinitionDetailParamsWithContext(ctx).
+		WithChaincodeID(data.ChaincodeID.ValueInt64()).
+		WithDefinitionID(data.DefinitionID.ValueInt64())
+	detail, err := r.client.API.Chaincode.GetChaincodeDefinitionDetail(params, nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return false, diags
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read chaincode definition, got error: %s", err))
+		return false, diags
+	}
+	if detail.Payload.Definition == nil {
+		return false, diags
+	}
+
+	cc, err := r.client.API.SmartContracts.GetFabricChaincodeDetail(smart_contracts.NewGetFabricChaincodeDetailParamsWithContext(ctx).WithID(strconv.FormatInt(data.ChaincodeID.ValueInt64(), 10)), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return false, diags
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read chaincode, got error: %s", err))
+		return false, diags
+	}
+
+	data.Version = types.StringValue(detail.Payload.Definition.Version)
+	data.Sequence = types.Int64Value(detail.Payload.Definition.Sequence)
+	data.Name = types.StringValue(cc.Payload.Name)
+	// Fabric networks are named after their channel
+	data.ChannelName = types.StringValue(cc.Payload.NetworkName)
+	return true, diags
+}
+
+// refresh asks every peer of data for the definitions committed on the
+// channel and records them in peers. With dropOutOfSync, approve and commit
+// peers that are out of sync are also removed from the configured peers, so
+// that the next plan runs their step again.
+func (r *FabricChaincodeLifecycleResource) refresh(ctx context.Context, data *FabricChaincodeLifecycleResourceModel, dropOutOfSync bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Name.IsUnknown() || data.Name.IsNull() {
+		found, readDiags := r.readDefinition(ctx, data)
+		diags.Append(readDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		if !found {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read chaincode definition %d: it no longer exists", data.DefinitionID.ValueInt64()))
+			return diags
+		}
+	}
+
+	var installPeerIDs, approvePeerIDs []int64
+	diags.Append(data.InstallPeerIDs.ElementsAs(ctx, &installPeerIDs, false)...)
+	diags.Append(data.ApprovePeerIDs.ElementsAs(ctx, &approvePeerIDs, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	peerIDs := slices.Concat(installPeerIDs, approvePeerIDs)
+	if !data.CommitPeerID.IsNull() {
+		peerIDs = append(peerIDs, data.CommitPeerID.ValueInt64())
+	}
+	slices.Sort(peerIDs)
+	peerIDs = slices.Compact(peerIDs)
+
+	peers := make([]FabricChaincodeLifecyclePeerModel, 0, len(peerIDs))
+	inSync := make(map[int64]bool, len(peerIDs))
+	for _, peerID := range peerIDs {
+		peer := FabricChaincodeLifecyclePeerModel{
+			PeerID:   types.Int64Value(peerID),
+			Version:  types.StringNull(),
+			Sequence: types.Int64Null(),
+		}
+
+		params := nodes.NewGetNodeChaincodesParamsWithContext(ctx).WithID(peerID).WithChannelID(data.ChannelName.ValueString())
+		committed, err := r.client.API.Nodes.GetNodeChaincodes(params, nil)
+		if err != nil && !IsNotFoundError(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read chaincodes committed on peer %d, got error: %s", peerID, err))
+			return diags
+		}
+		// A peer that is gone, or not joined to the channel, reports nothing
+		if err == nil {
+			for _, cc := range committed.Payload {
+				if cc != nil && cc.Name == data.Name.ValueString() {
+					peer.Version = types.StringValue(cc.Version)
+					peer.Sequence = types.Int64Value(cc.Sequence)
+					break
+				}
+			}
+		}
+
+		// A later sequence means the definition was upgraded, not lost
+		inSync[peerID] = !peer.Sequence.IsNull() && peer.Sequence.ValueInt64() >= data.Sequence.ValueInt64()
+		peer.InSync = types.BoolValue(inSync[peerID])
+		peers = append(peers, peer)
+	}
+
+	var listDiags diag.Diagnostics
+	data.Peers, listDiags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: chaincodeLifecyclePeerAttrTypes}, peers)
+	diags.Append(listDiags...)
+	if diags.HasError() || !dropOutOfSync {
+		return diags
+	}
+
+	// The committed definition says nothing about what a peer has installed,
+	// and a peer that only installs need not have joined the channel, so
+	// install peers are kept. The API has no query for the packages installed
+	// on a peer, so a removed package does not show up as drift.
+	var setDiags diag.Diagnostics
+	data.ApprovePeerIDs, setDiags = types.SetValueFrom(ctx, types.Int64Type, slices.DeleteFunc(approvePeerIDs, func(id int64) bool { return !inSync[id] }))
+	diags.Append(setDiags...)
+	if !data.CommitPeerID.IsNull() && !inSync[data.CommitPeerID.ValueInt64()] {
+		data.CommitPeerID = types.Int64Null()
+	}
+	return diags
+}
+
+// containerRunning reports whether the chaincode container of a definition
+// is running.
+func (r *FabricChaincodeLifecycleResource) containerRunning(ctx context.Context, definitionID int64) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	params := chaincode.NewGetChaincodeDefinitionDockerInfoParamsWithContext(ctx).WithDefinitionID(definitionID)
+	info, err := r.client.API.Chaincode.GetChaincodeDefinitionDockerInfo(params, nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return false, diags
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read chaincode container, got error: %s", err))
+		return false, diags
+	}
+	return strings.EqualFold(info.Payload.State, "running"), diags
+}
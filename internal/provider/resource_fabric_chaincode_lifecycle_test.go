@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestFabricChaincodeLifecycleReadDropsOutOfSyncPeers checks that approve
+// and commit peers which no longer report the definition as committed are
+// removed from state, so that the next plan approves or commits with them
+// again, while install peers are kept even when they have not joined the
+// channel.
+func TestFabricChaincodeLifecycleReadDropsOutOfSyncPeers(t *testing.T) {
+	ctx := context.Background()
+
+	responses := map[string]string{
+		"/api/v1/sc/fabric/chaincodes/7/definitions/9":  `{"definition":{"id":9,"chaincode_id":7,"version":"1.0","sequence":2}}`,
+		"/api/v1/sc/fabric/chaincodes/7":                `{"id":7,"name":"basic","network_name":"mychannel"}`,
+		"/api/v1/nodes/1/channels/mychannel/chaincodes": `[{"name":"basic","version":"1.0","sequence":2}]`,
+		"/api/v1/nodes/2/channels/mychannel/chaincodes": `[{"name":"other","version":"1.0","sequence":1}]`,
+		"/api/v1/nodes/3/channels/mychannel/chaincodes": `[{"name":"basic","version":"2.0","sequence":3}]`,
+		"/api/v1/sc/fabric/definitions/9/docker-info":   `{"state":"exited"}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	r := &FabricChaincodeLifecycleResource{client: NewClient(server.URL, "", "test", "test")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	diags := state.Set(ctx, &FabricChaincodeLifecycleResourceModel{
+		ID:                   types.StringValue("9"),
+		ChaincodeID:          types.Int64Value(7),
+		DefinitionID:         types.Int64Value(9),
+		InstallPeerIDs:       testInt64Set(t, 1, 2, 3, 4),
+		ApprovePeerIDs:       testInt64Set(t, 1, 2),
+		CommitPeerID:         types.Int64Value(2),
+		Deploy:               types.BoolValue(true),
+		EnvironmentVariables: types.MapNull(types.StringType),
+		Name:                 types.StringValue("basic"),
+		ChannelName:          types.StringValue("mychannel"),
+		Version:              types.StringValue("1.0"),
+		Sequence:             types.Int64Value(2),
+		Peers:                types.ListNull(types.ObjectType{AttrTypes: chaincodeLifecyclePeerAttrTypes}),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		})},
+	})
+	if diags.HasError() {
+		t.Fatalf("unable to build state: %v", diags)
+	}
+
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+	}
+
+	var got FabricChaincodeLifecycleResourceModel
+	if diags := readResp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+
+	var installPeerIDs, approvePeerIDs []int64
+	got.InstallPeerIDs.ElementsAs(ctx, &installPeerIDs, false)
+	got.ApprovePeerIDs.ElementsAs(ctx, &approvePeerIDs, false)
+	slices.Sort(installPeerIDs)
+	if !reflect.DeepEqual(installPeerIDs, []int64{1, 2, 3, 4}) {
+		t.Errorf("install_peer_ids = %v, want [1 2 3 4]", installPeerIDs)
+	}
+	if !reflect.DeepEqual(approvePeerIDs, []int64{1}) {
+		t.Errorf("approve_peer_ids = %v, want [1]", approvePeerIDs)
+	}
+	if !got.CommitPeerID.IsNull() {
+		t.Errorf("commit_peer_id = %s, want null", got.CommitPeerID)
+	}
+	if got.Deploy.ValueBool() {
+		t.Error("deploy = true, want false for a stopped container")
+	}
+
+	var peers []FabricChaincodeLifecyclePeerModel
+	got.Peers.ElementsAs(ctx, &peers, false)
+	inSync := map[int64]bool{}
+	for _, peer := range peers {
+		inSync[peer.PeerID.ValueInt64()] = peer.InSync.ValueBool()
+	}
+	if want := map[int64]bool{1: true, 2: false, 3: true, 4: false}; !reflect.DeepEqual(inSync, want) {
+		t.Errorf("peers in sync = %v, want %v", inSync, want)
+	}
+}
+
+func testInt64Set(t *testing.T, values ...int64) types.Set {
+	t.Helper()
+
+	set, diags := types.SetValueFrom(context.Background(), types.Int64Type, values)
+	if diags.HasError() {
+		t.Fatalf("unable to build set: %v", diags)
+	}
+	return set
+}
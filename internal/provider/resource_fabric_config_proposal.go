@@ -0,0 +1,498 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/governance"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &FabricConfigProposalResource{}
+var _ resource.ResourceWithModifyPlan = &FabricConfigProposalResource{}
+var _ resource.ResourceWithValidateConfig = &FabricConfigProposalResource{}
+
+func NewFabricConfigProposalResource() resource.Resource {
+	return &FabricConfigProposalResource{}
+}
+
+// FabricConfigProposalResource manages a channel config update proposal that
+// has to be signed by several organizations before it is submitted.
+type FabricConfigProposalResource struct {
+	client *Client
+}
+
+type FabricConfigProposalResourceModel struct {
+	ID                  types.String                         `tfsdk:"id"`
+	NetworkID           types.Int64                          `tfsdk:"network_id"`
+	ProposalType        types.String                         `tfsdk:"proposal_type"`
+	Operations          []FabricConfigProposalOperationModel `tfsdk:"operations"`
+	SharedConnectionIDs types.Set                            `tfsdk:"shared_connection_ids"`
+	AutoSubmitWhen      types.Int64                          `tfsdk:"auto_submit_when"`
+	ChannelName         types.String                         `tfsdk:"channel_name"`
+	Status              types.String                         `tfsdk:"status"`
+	PreviewJSON         types.String                         `tfsdk:"preview_json"`
+	Signatures          types.List                           `tfsdk:"signatures"`
+	CreatedBy           types.String                         `tfsdk:"created_by"`
+	CreatedAt           types.String                         `tfsdk:"created_at"`
+	TransactionID       types.String                         `tfsdk:"transaction_id"`
+}
+
+type FabricConfigProposalOperationModel struct {
+	Type    types.String `tfsdk:"type"`
+	Payload types.String `tfsdk:"payload"`
+}
+
+type FabricConfigProposalSignatureModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	MspID    types.String `tfsdk:"msp_id"`
+	SignedBy types.String `tfsdk:"signed_by"`
+	SignedAt types.String `tfsdk:"signed_at"`
+}
+
+// proposalSignatureAttrTypes describes the elements of the signatures list.
+var proposalSignatureAttrTypes = map[string]attr.Type{
+	"id":        types.Int64Type,
+	"msp_id":    types.StringType,
+	"signed_by": types.StringType,
+	"signed_at": types.StringType,
+}
+
+func (r *FabricConfigProposalResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_config_proposal"
+}
+
+func (r *FabricConfigProposalResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Proposes a channel config update that needs signatures from several organizations (Chainlaunch Pro). " +
+			"The proposal is shared with the other organizations, which sign it with chainlaunch_fabric_config_proposal_signature, and is submitted once enough signatures were collected. " +
+			"Note: Deleting this resource only removes it from Terraform state - proposals cannot be withdrawn.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the proposal.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the Fabric network whose channel configuration is updated.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"proposal_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The kind of change, shown to the organizations asked to sign, such as add_org.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"operations": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The channel config update operations of the proposal, applied in order.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The operation type, such as add_org, remove_org, update_org_msp or update_application_policy.",
+						},
+						"payload": schema.StringAttribute{
+							Required:    true,
+							Description: "The JSON payload of the operation, typically built with jsonencode. For add_org: {msp_id, root_certs, tls_root_certs}.",
+						},
+					},
+				},
+			},
+			"shared_connection_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the node sharing connections of the organizations the proposal is shared with. " +
+					"Adding an ID shares the proposal; removing one does not unshare it.",
+			},
+			"auto_submit_when": schema.Int64Attribute{
+				Optional: true,
+				Description: "Submit the proposal once it has at least this many signatures. Signatures are counted on every refresh, so a later plan submits the proposal. " +
+					"Leave unset to submit it outside Terraform.",
+			},
+			"channel_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the channel the proposal updates.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the proposal.",
+			},
+			"preview_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The channel configuration that results from the proposal, as JSON, for reviewing it before signing.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signatures": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The signatures collected so far.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the signature.",
+						},
+						"msp_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The MSP ID of the organization that signed.",
+						},
+						"signed_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identity that signed.",
+						},
+						"signed_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp of the signature.",
+						},
+					},
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user or organization that created the proposal.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the proposal was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transaction_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the config update transaction, set once the proposal was submitted.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FabricConfigProposalResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FabricConfigProposalResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Operations != nil && len(data.Operations) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("operations"), "Invalid Operations", "A proposal needs at least one operation.")
+	}
+	for i, op := range data.Operations {
+		if !op.Type.IsNull() && !op.Type.IsUnknown() {
+			if err := models.FabricConfigUpdateOperationType(op.Type.ValueString()).Validate(nil); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("operations").AtListIndex(i).AtName("type"), "Invalid Operation Type", fmt.Sprintf("Unsupported config update operation type %q.", op.Type.ValueString()))
+			}
+		}
+		if !op.Payload.IsNull() && !op.Payload.IsUnknown() && !json.Valid([]byte(op.Payload.ValueString())) {
+			resp.Diagnostics.AddAttributeError(path.Root("operations").AtListIndex(i).AtName("payload"), "Invalid Operation Payload", "payload must be valid JSON.")
+		}
+	}
+
+	if !data.AutoSubmitWhen.IsNull() && !data.AutoSubmitWhen.IsUnknown() && data.AutoSubmitWhen.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("auto_submit_when"), "Invalid Threshold", "auto_submit_when must be at least 1.")
+	}
+}
+
+func (r *FabricConfigProposalResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans an update when the proposal collected enough signatures
+// since the last apply, so that the update submits it.
+func (r *FabricConfigProposalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan FabricConfigProposalResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A replacement creates a new proposal, which is checked after creation
+	if !plan.ID.Equal(state.ID) {
+		return
+	}
+
+	if readyToSubmit(plan.AutoSubmitWhen, len(state.Signatures.Elements()), state.Status, state.TransactionID) {
+		plan.Status = types.StringUnknown()
+		plan.TransactionID = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+func (r *FabricConfigProposalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FabricConfigProposalResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &models.ProPrepareConfigUpdateRequest{
+		NetworkID:    data.NetworkID.ValueInt64Pointer(),
+		ProposalType: data.ProposalType.ValueStringPointer(),
+	}
+	for i, op := range data.Operations {
+		var payload interface{}
+		if err := json.Unmarshal([]byte(op.Payload.ValueString()), &payload); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("operations").AtListIndex(i).AtName("payload"), "Invalid Operation Payload", fmt.Sprintf("Unable to parse payload JSON: %s", err))
+			return
+		}
+		createReq.Operations = append(createReq.Operations, &models.FabricConfigUpdateOperation{
+			Type:    models.FabricConfigUpdateOperationType(op.Type.ValueString()),
+			Payload: payload,
+		})
+	}
+
+	created, err := r.client.API.Governance.CreateGovernanceProposal(governance.NewCreateGovernanceProposalParamsWithContext(ctx).WithRequest(createReq), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create config update proposal, got error: %s", err))
+		return
+	}
+	data.ID = types.StringValue(created.Payload.ID)
+	data.TransactionID = types.StringNull()
+
+	// A proposal that failed to be shared is abandoned rather than kept in
+	// state, just as deleting it would
+	resp.Diagnostics.Append(r.share(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshAndSubmit(ctx, &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricConfigProposalResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FabricConfigProposalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, err := r.client.API.Governance.GetGovernanceProposal(governance.NewGetGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config update proposal, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(setProposal(ctx, &data, got.Payload)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricConfigProposalResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FabricConfigProposalResourceModel
+	var state FabricConfigProposalResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the sharing and the submission threshold change in place
+	data.TransactionID = state.TransactionID
+
+	resp.Diagnostics.Append(r.share(ctx, &data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.refreshAndSubmit(ctx, &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricConfigProposalResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The API has no endpoint to withdraw a proposal, and one that was never
+	// submitted has no effect on the channel, so deletion only removes the
+	// resource from Terraform state
+}
+
+// share shares the proposal with the connections of data that are not in
+// state. A nil state shares it with all of them.
+func (r *FabricConfigProposalResource) share(ctx context.Context, data, state *FabricConfigProposalResourceModel) diag.Diagnostics {
+	previous := types.SetNull(types.Int64Type)
+	if state != nil {
+		previous = state.SharedConnectionIDs
+	}
+	connectionIDs, diags := addedConnectionIDs(ctx, data.SharedConnectionIDs, previous)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, connectionID := range connectionIDs {
+		params := governance.NewShareGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()).WithRequest(&models.ProShareProposalRequest{
+			ConnectionID: &connectionID,
+		})
+		if _, err := r.client.API.Governance.ShareGovernanceProposal(params, nil); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to share config update proposal with connection %d, got error: %s", connectionID, err))
+			return diags
+		}
+	}
+	return diags
+}
+
+// addedConnectionIDs returns the node sharing connection IDs of planned that
+// are not in previous. Sharing is additive, so removed IDs are ignored.
+func addedConnectionIDs(ctx context.Context, planned, previous types.Set) ([]int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var connectionIDs, shared []int64
+	if !planned.IsNull() && !planned.IsUnknown() {
+		diags.Append(planned.ElementsAs(ctx, &connectionIDs, false)...)
+	}
+	if !previous.IsNull() && !previous.IsUnknown() {
+		diags.Append(previous.ElementsAs(ctx, &shared, false)...)
+	}
+
+	var added []int64
+	for _, connectionID := range connectionIDs {
+		if !slices.Contains(shared, connectionID) {
+			added = append(added, connectionID)
+		}
+	}
+	return added, diags
+}
+
+// refreshAndSubmit reads the proposal and submits it when auto_submit_when
+// is reached.
+func (r *FabricConfigProposalResource) refreshAndSubmit(ctx context.Context, data *FabricConfigProposalResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	got, err := r.client.API.Governance.GetGovernanceProposal(governance.NewGetGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read config update proposal, got error: %s", err))
+		return diags
+	}
+	proposal := got.Payload
+
+	if readyToSubmit(data.AutoSubmitWhen, len(proposal.Signatures), types.StringValue(proposal.Status), data.TransactionID) {
+		submitted, err := r.client.API.Governance.SubmitGovernanceProposal(governance.NewSubmitGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()), nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to submit config update proposal, got error: %s", err))
+			return diags
+		}
+		data.TransactionID = stringValueOrNull(submitted.Payload.TxID)
+
+		got, err = r.client.API.Governance.GetGovernanceProposal(governance.NewGetGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ID.ValueString()), nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read config update proposal, got error: %s", err))
+			return diags
+		}
+		proposal = got.Payload
+	}
+
+	diags.Append(setProposal(ctx, data, proposal)...)
+	return diags
+}
+
+// readyToSubmit reports whether a proposal with the given number of
+// signatures has reached its auto_submit_when threshold and was not
+// submitted yet.
+func readyToSubmit(threshold types.Int64, signatures int, status, transactionID types.String) bool {
+	if threshold.IsNull() || threshold.IsUnknown() {
+		return false
+	}
+	if !transactionID.IsNull() && !transactionID.IsUnknown() && transactionID.ValueString() != "" {
+		return false
+	}
+	if strings.EqualFold(status.ValueString(), "submitted") {
+		return false
+	}
+	return int64(signatures) >= threshold.ValueInt64()
+}
+
+// setProposal copies the proposal returned by the API into data. The
+// operations are kept as configured, since the API returns their payloads
+// re-encoded.
+func setProposal(ctx context.Context, data *FabricConfigProposalResourceModel, proposal *models.ProProposalResponse) diag.Diagnostics {
+	data.NetworkID = types.Int64Value(proposal.NetworkID)
+	data.ChannelName = stringValueOrNull(proposal.ChannelName)
+	data.Status = stringValueOrNull(proposal.Status)
+	data.PreviewJSON = stringValueOrNull(proposal.PreviewJSON)
+	data.CreatedBy = stringValueOrNull(proposal.CreatedBy)
+	data.CreatedAt = stringValueOrNull(proposal.CreatedAt)
+	if data.TransactionID.IsUnknown() {
+		data.TransactionID = types.StringNull()
+	}
+
+	signatures := make([]FabricConfigProposalSignatureModel, 0, len(proposal.Signatures))
+	for _, signature := range proposal.Signatures {
+		if signature == nil {
+			continue
+		}
+		signatures = append(signatures, FabricConfigProposalSignatureModel{
+			ID:       types.Int64Value(signature.ID),
+			MspID:    types.StringValue(signature.MspID),
+			SignedBy: stringValueOrNull(signature.SignedBy),
+			SignedAt: stringValueOrNull(signature.SignedAt),
+		})
+	}
+	var diags diag.Diagnostics
+	data.Signatures, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: proposalSignatureAttrTypes}, signatures)
+	return diags
+}
@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/governance"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &FabricConfigProposalSignatureResource{}
+
+func NewFabricConfigProposalSignatureResource() resource.Resource {
+	return &FabricConfigProposalSignatureResource{}
+}
+
+// FabricConfigProposalSignatureResource signs a channel config update proposal
+// on behalf of one organization.
+type FabricConfigProposalSignatureResource struct {
+	client *Client
+}
+
+type FabricConfigProposalSignatureResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ProposalID   types.String `tfsdk:"proposal_id"`
+	MspID        types.String `tfsdk:"msp_id"`
+	ConnectionID types.Int64  `tfsdk:"connection_id"`
+	SignedBy     types.String `tfsdk:"signed_by"`
+	SignedAt     types.String `tfsdk:"signed_at"`
+}
+
+func (r *FabricConfigProposalSignatureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_config_proposal_signature"
+}
+
+func (r *FabricConfigProposalSignatureResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Signs a channel config update proposal (Chainlaunch Pro) with the admin identity of an organization, typically from the Terraform workspace of another organization than the one that proposed it. " +
+			"Destroying the resource withdraws the signature.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the signature.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"proposal_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the proposal to sign.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"msp_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The MSP ID of the organization that signs. Its admin identity must be managed by this Chainlaunch instance.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the node sharing connection to send the signature back to the organization that created the proposal. Leave unset when it was created on this instance.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"signed_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identity that signed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signed_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the signature.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FabricConfigProposalSignatureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FabricConfigProposalSignatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FabricConfigProposalSignatureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := governance.NewSignGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()).WithRequest(&models.ProSignConfigUpdateRequest{
+		MspID: data.MspID.ValueStringPointer(),
+	})
+	signed, err := r.client.API.Governance.SignGovernanceProposal(params, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to sign config update proposal, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(signed.Payload.ID, 10))
+
+	// The sign response identifies the signer by user ID, so read the signature
+	// back as the proposal lists it
+	got, err := r.client.API.Governance.GetGovernanceProposal(governance.NewGetGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config update proposal, got error: %s", err))
+		return
+	}
+	if signature := findProposalSignature(got.Payload, signed.Payload.ID); signature != nil {
+		setProposalSignature(&data, signature)
+	} else {
+		data.SignedBy = types.StringNull()
+		data.SignedAt = stringValueOrNull(signed.Payload.SignedAt)
+	}
+
+	if !data.ConnectionID.IsNull() {
+		shareParams := governance.NewShareGovernanceSignatureParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()).WithRequest(&models.ProShareSignatureRequest{
+			ConnectionID: data.ConnectionID.ValueInt64Pointer(),
+			SignatureID:  &signed.Payload.ID,
+		})
+		if _, err := r.client.API.Governance.ShareGovernanceSignature(shareParams, nil); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to share signature with connection %d, got error: %s", data.ConnectionID.ValueInt64(), err))
+			// Keep the signature in state so that it is withdrawn when the
+			// tainted resource is replaced
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricConfigProposalSignatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FabricConfigProposalSignatureResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.Governance.GetGovernanceProposal(governance.NewGetGovernanceProposalParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config update proposal, got error: %s", err))
+		return
+	}
+
+	signature := findProposalSignature(got.Payload, id)
+	if signature == nil {
+		// The signature was withdrawn outside Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	setProposalSignature(&data, signature)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricConfigProposalSignatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The API has no update endpoint for signatures; every attribute that can
+	// be configured forces a replacement, so there is nothing to send here.
+	var data FabricConfigProposalSignatureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricConfigProposalSignatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FabricConfigProposalSignatureResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	params := governance.NewDeleteGovernanceSignatureParamsWithContext(ctx).WithProposalID(data.ProposalID.ValueString()).WithSignatureID(id)
+	_, err = r.client.API.Governance.DeleteGovernanceSignature(params, nil)
+	if err != nil {
+		// The signature or its proposal is already gone, for instance withdrawn
+		// or deleted outside of Terraform
+		if IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to withdraw signature, got error: %s", err))
+		return
+	}
+}
+
+// findProposalSignature returns the signature of the proposal with the given
+// ID, or nil when there is none.
+func findProposalSignature(proposal *models.ProProposalResponse, id int64) *models.ProSignatureResponse {
+	for _, signature := range proposal.Signatures {
+		if signature != nil && signature.ID == id {
+			return signature
+		}
+	}
+	return nil
+}
+
+// setProposalSignature copies a signature listed by the proposal into data.
+func setProposalSignature(data *FabricConfigProposalSignatureResourceModel, signature *models.ProSignatureResponse) {
+	data.MspID = types.StringValue(signature.MspID)
+	data.SignedBy = stringValueOrNull(signature.SignedBy)
+	data.SignedAt = stringValueOrNull(signature.SignedAt)
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReadyToSubmit(t *testing.T) {
+	tests := []struct {
+		name          string
+		threshold     types.Int64
+		signatures    int
+		status        types.String
+		transactionID types.String
+		want          bool
+	}{
+		{"no threshold", types.Int64Null(), 3, types.StringValue("pending"), types.StringNull(), false},
+		{"below threshold", types.Int64Value(2), 1, types.StringValue("pending"), types.StringNull(), false},
+		{"threshold reached", types.Int64Value(2), 2, types.StringValue("pending"), types.StringNull(), true},
+		{"already submitted", types.Int64Value(2), 2, types.StringValue("pending"), types.StringValue("tx1"), false},
+		{"submitted outside Terraform", types.Int64Value(2), 3, types.StringValue("SUBMITTED"), types.StringNull(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := readyToSubmit(tt.threshold, tt.signatures, tt.status, tt.transactionID); got != tt.want {
+				t.Errorf("readyToSubmit() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddedConnectionIDs(t *testing.T) {
+	ctx := context.Background()
+	planned, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{1, 2, 3})
+	previous, _ := types.SetValueFrom(ctx, types.Int64Type, []int64{2, 4})
+
+	got, diags := addedConnectionIDs(ctx, planned, previous)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if want := []int64{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	got, _ = addedConnectionIDs(ctx, types.SetNull(types.Int64Type), previous)
+	if len(got) != 0 {
+		t.Errorf("expected no connections to share with, got %v", got)
+	}
+}
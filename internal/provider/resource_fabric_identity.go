@@ -2,8 +2,8 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var (
@@ -156,87 +159,43 @@ func (r *FabricIdentityResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Prepare request body
-	createReq := map[string]interface{}{
-		"name": data.Name.ValueString(),
-		"role": data.Role.ValueString(),
+	orgID, err := parseID(data.OrganizationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Organization ID", err.Error())
+		return
 	}
 
-	if !data.Description.IsNull() {
-		createReq["description"] = data.Description.ValueString()
+	// Prepare request body
+	createReq := &models.HandlerCreateKeyRequest{
+		Name:        data.Name.ValueStringPointer(),
+		Role:        data.Role.ValueStringPointer(),
+		Description: data.Description.ValueString(),
 	}
 
 	// Add DNS names if provided
 	if !data.DNSNames.IsNull() {
-		var dnsNames []string
-		data.DNSNames.ElementsAs(ctx, &dnsNames, false)
-		if len(dnsNames) > 0 {
-			createReq["dnsNames"] = dnsNames
-		}
+		resp.Diagnostics.Append(data.DNSNames.ElementsAs(ctx, &createReq.DNSNames, false)...)
 	}
 
 	// Add IP addresses if provided
 	if !data.IPAddresses.IsNull() {
-		var ipAddresses []string
-		data.IPAddresses.ElementsAs(ctx, &ipAddresses, false)
-		if len(ipAddresses) > 0 {
-			createReq["ipAddresses"] = ipAddresses
-		}
+		resp.Diagnostics.Append(data.IPAddresses.ElementsAs(ctx, &createReq.IPAddresses, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Create identity via API
-	identityResp, err := r.client.DoRequest("POST", fmt.Sprintf("/organizations/%s/keys", data.OrganizationID.ValueString()), createReq)
+	params := organizations.NewCreateOrganizationKeyParamsWithContext(ctx).WithID(orgID).WithRequest(createReq)
+	created, err := r.client.API.Organizations.CreateOrganizationKey(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Fabric identity", err.Error())
 		return
 	}
 
-	// Parse response
-	var identityResult map[string]interface{}
-	if err := json.Unmarshal(identityResp, &identityResult); err != nil {
-		resp.Diagnostics.AddError("Failed to parse identity response", err.Error())
-		return
-	}
-
-	// Set computed fields
-	if id, ok := identityResult["id"].(float64); ok {
-		data.ID = types.StringValue(fmt.Sprintf("%d", int64(id)))
-	}
-	if algorithm, ok := identityResult["algorithm"].(string); ok {
-		data.Algorithm = types.StringValue(algorithm)
-	}
-	if certificate, ok := identityResult["certificate"].(string); ok {
-		data.Certificate = types.StringValue(certificate)
-	}
-	if publicKey, ok := identityResult["publicKey"].(string); ok {
-		data.PublicKey = types.StringValue(publicKey)
-	}
-	if sha1Fingerprint, ok := identityResult["sha1Fingerprint"].(string); ok {
-		data.SHA1Fingerprint = types.StringValue(sha1Fingerprint)
-	}
-
-	// Handle optional fields - set to null if not provided
-	if ethereumAddress, ok := identityResult["ethereumAddress"].(string); ok && ethereumAddress != "" {
-		data.EthereumAddress = types.StringValue(ethereumAddress)
-	} else {
-		data.EthereumAddress = types.StringNull()
-	}
-
-	if expiresAt, ok := identityResult["expiresAt"].(string); ok && expiresAt != "" {
-		data.ExpiresAt = types.StringValue(expiresAt)
-	} else {
-		data.ExpiresAt = types.StringNull()
-	}
-
-	if lastRotatedAt, ok := identityResult["lastRotatedAt"].(string); ok && lastRotatedAt != "" {
-		data.LastRotatedAt = types.StringValue(lastRotatedAt)
-	} else {
-		data.LastRotatedAt = types.StringNull()
-	}
-
-	if createdAt, ok := identityResult["createdAt"].(string); ok {
-		data.CreatedAt = types.StringValue(createdAt)
-	}
+	data.ID = types.StringValue(strconv.FormatInt(created.Payload.ID, 10))
+	setFabricIdentityKey(&data, created.Payload)
+	data.CreatedAt = types.StringValue(created.Payload.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -249,79 +208,45 @@ func (r *FabricIdentityResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	// Get identity from API
-	identityResp, err := r.client.DoRequest("GET", fmt.Sprintf("/organizations/%s/keys", data.OrganizationID.ValueString()), nil)
+	orgID, err := parseID(data.OrganizationID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to read identity", err.Error())
+		resp.Diagnostics.AddError("Invalid Organization ID", err.Error())
 		return
 	}
-
-	// Parse response (map of keys)
-	var keysResult map[string]interface{}
-	if err := json.Unmarshal(identityResp, &keysResult); err != nil {
-		resp.Diagnostics.AddError("Failed to parse keys response", err.Error())
-		return
-	}
-
-	// Find our key in the map
-	// The API returns: {"keys": {"1": {...}, "2": {...}}}
-	keysMap, ok := keysResult["keys"].(map[string]interface{})
-	if !ok {
-		resp.Diagnostics.AddError("Invalid keys response", "Expected 'keys' object")
+	keyID, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var identityResult map[string]interface{}
-	keyID := data.ID.ValueString()
-	found := false
-
-	// Look up our key by ID
-	if keyData, exists := keysMap[keyID]; exists {
-		if keyMap, ok := keyData.(map[string]interface{}); ok {
-			identityResult = keyMap
-			found = true
+	// Get identity from API
+	params := organizations.NewGetOrganizationKeyParamsWithContext(ctx).WithID(orgID).WithKeyID(keyID)
+	key, err := r.client.API.Organizations.GetOrganizationKey(params, nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
 		}
-	}
-
-	if !found {
-		resp.State.RemoveResource(ctx)
+		resp.Diagnostics.AddError("Failed to read identity", err.Error())
 		return
 	}
 
-	// Update computed fields
-	if algorithm, ok := identityResult["algorithm"].(string); ok {
-		data.Algorithm = types.StringValue(algorithm)
-	}
-	if certificate, ok := identityResult["certificate"].(string); ok {
-		data.Certificate = types.StringValue(certificate)
-	}
-	if publicKey, ok := identityResult["publicKey"].(string); ok {
-		data.PublicKey = types.StringValue(publicKey)
-	}
-	if sha1Fingerprint, ok := identityResult["sha1Fingerprint"].(string); ok {
-		data.SHA1Fingerprint = types.StringValue(sha1Fingerprint)
-	}
-
-	// Handle optional fields - set to null if not provided
-	if ethereumAddress, ok := identityResult["ethereumAddress"].(string); ok && ethereumAddress != "" {
-		data.EthereumAddress = types.StringValue(ethereumAddress)
-	} else {
-		data.EthereumAddress = types.StringNull()
-	}
+	setFabricIdentityKey(&data, key.Payload)
 
-	if expiresAt, ok := identityResult["expiresAt"].(string); ok && expiresAt != "" {
-		data.ExpiresAt = types.StringValue(expiresAt)
-	} else {
-		data.ExpiresAt = types.StringNull()
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	if lastRotatedAt, ok := identityResult["lastRotatedAt"].(string); ok && lastRotatedAt != "" {
-		data.LastRotatedAt = types.StringValue(lastRotatedAt)
-	} else {
-		data.LastRotatedAt = types.StringNull()
-	}
+// setFabricIdentityKey copies the computed attributes of an organization key into data.
+func setFabricIdentityKey(data *FabricIdentityResourceModel, key *models.HandlerKeyResponse) {
+	data.Algorithm = types.StringValue(key.Algorithm)
+	data.Certificate = types.StringValue(key.Certificate)
+	data.PublicKey = types.StringValue(key.PublicKey)
+	data.SHA1Fingerprint = types.StringValue(key.Sha1Fingerprint)
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// Handle optional fields - set to null if not provided
+	data.EthereumAddress = stringValueOrNull(key.EthereumAddress)
+	data.ExpiresAt = stringValueOrNull(key.ExpiresAt)
+	data.LastRotatedAt = stringValueOrNull(key.LastRotatedAt)
 }
 
 func (r *FabricIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -345,8 +270,20 @@ func (r *FabricIdentityResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	orgID, err := parseID(data.OrganizationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Organization ID", err.Error())
+		return
+	}
+	keyID, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
 	// Delete identity via API
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/organizations/%s/keys/%s", data.OrganizationID.ValueString(), data.ID.ValueString()), nil)
+	params := organizations.NewDeleteOrganizationKeyParamsWithContext(ctx).WithID(orgID).WithKeyID(keyID)
+	_, err = r.client.API.Organizations.DeleteOrganizationKey(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete Fabric identity", err.Error())
 		return
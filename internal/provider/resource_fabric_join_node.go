@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -14,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
 )
 
 var _ resource.Resource = &FabricJoinNodeResource{}
@@ -101,29 +102,25 @@ func (r *FabricJoinNodeResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Use the role-specific join endpoint
-	var endpoint string
-	if data.Role.ValueString() == "peer" {
-		endpoint = fmt.Sprintf("/networks/fabric/%d/peers/%d/join", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64())
-	} else if data.Role.ValueString() == "orderer" {
-		endpoint = fmt.Sprintf("/networks/fabric/%d/orderers/%d/join", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64())
-	} else {
+	networkID, nodeID := data.NetworkID.ValueInt64(), data.NodeID.ValueInt64()
+
+	var err error
+	switch data.Role.ValueString() {
+	case "peer":
+		params := fabric_networks.NewJoinFabricPeerParamsWithContext(ctx).WithID(networkID).WithPeerID(nodeID)
+		_, err = r.client.API.FabricNetworks.JoinFabricPeer(params, nil)
+	case "orderer":
+		params := fabric_networks.NewJoinFabricOrdererParamsWithContext(ctx).WithID(networkID).WithOrdererID(nodeID)
+		_, err = r.client.API.FabricNetworks.JoinFabricOrderer(params, nil)
+	default:
 		resp.Diagnostics.AddError("Invalid Role", fmt.Sprintf("Role must be 'peer' or 'orderer', got: %s", data.Role.ValueString()))
 		return
 	}
-
-	body, err := r.client.DoRequest("POST", endpoint, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to join node to channel, got error: %s", err))
 		return
 	}
 
-	// Parse response to verify success
-	var networkResp FabricNetworkResponse
-	if err := json.Unmarshal(body, &networkResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response: %s", err))
-		return
-	}
-
 	// Set the composite ID
 	data.ID = types.StringValue(fmt.Sprintf("%d:%d", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64()))
 
@@ -139,24 +136,21 @@ func (r *FabricJoinNodeResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get the list of nodes in the network
-	endpoint := fmt.Sprintf("/networks/fabric/%d/nodes", data.NetworkID.ValueInt64())
-
-	body, err := r.client.DoRequest("GET", endpoint, nil)
+	params := fabric_networks.NewGetFabricNetworkNodesParamsWithContext(ctx).WithID(data.NetworkID.ValueInt64())
+	nodesResp, err := r.client.API.FabricNetworks.GetFabricNetworkNodes(params, nil)
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network nodes, got error: %s", err))
 		return
 	}
 
-	var nodesResp GetNetworkNodesResponse
-	if err := json.Unmarshal(body, &nodesResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse nodes response: %s", err))
-		return
-	}
-
 	// Check if the node is still in the network
 	found := false
-	for _, node := range nodesResp.Nodes {
-		if node.NodeID == data.NodeID.ValueInt64() {
+	for _, node := range nodesResp.Payload.Nodes {
+		if node != nil && node.NodeID == data.NodeID.ValueInt64() {
 			found = true
 			break
 		}
@@ -189,17 +183,20 @@ func (r *FabricJoinNodeResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Use the unjoin endpoint to remove node from channel
-	var endpoint string
-	if data.Role.ValueString() == "peer" {
-		endpoint = fmt.Sprintf("/networks/fabric/%d/peers/%d/unjoin", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64())
-	} else if data.Role.ValueString() == "orderer" {
-		endpoint = fmt.Sprintf("/networks/fabric/%d/orderers/%d/unjoin", data.NetworkID.ValueInt64(), data.NodeID.ValueInt64())
-	} else {
+	networkID, nodeID := data.NetworkID.ValueInt64(), data.NodeID.ValueInt64()
+
+	var err error
+	switch data.Role.ValueString() {
+	case "peer":
+		params := fabric_networks.NewUnjoinFabricPeerParamsWithContext(ctx).WithID(networkID).WithPeerID(nodeID)
+		_, err = r.client.API.FabricNetworks.UnjoinFabricPeer(params, nil)
+	case "orderer":
+		params := fabric_networks.NewUnjoinFabricOrdererParamsWithContext(ctx).WithID(networkID).WithOrdererID(nodeID)
+		_, err = r.client.API.FabricNetworks.UnjoinFabricOrderer(params, nil)
+	default:
 		resp.Diagnostics.AddError("Invalid Role", fmt.Sprintf("Unknown role: %s", data.Role.ValueString()))
 		return
 	}
-
-	_, err := r.client.DoRequest("POST", endpoint, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unjoin node from channel, got error: %s", err))
 		return
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -13,10 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricNetworkResource{}
 var _ resource.ResourceWithImportState = &FabricNetworkResource{}
+var _ resource.ResourceWithModifyPlan = &FabricNetworkResource{}
 
 func NewFabricNetworkResource() resource.Resource {
 	return &FabricNetworkResource{}
@@ -59,10 +66,11 @@ type FabricNetworkResourceModel struct {
 	ChannelPolicies     map[string]FabricPolicyModel `tfsdk:"channel_policies"`
 
 	// Computed
-	Platform  types.String `tfsdk:"platform"`
-	Status    types.String `tfsdk:"status"`
-	CreatedAt types.String `tfsdk:"created_at"`
-	UpdatedAt types.String `tfsdk:"updated_at"`
+	Platform      types.String `tfsdk:"platform"`
+	Status        types.String `tfsdk:"status"`
+	TransactionID types.String `tfsdk:"transaction_id"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
 }
 
 type OrganizationConfigModel struct {
@@ -686,6 +694,10 @@ func (r *FabricNetworkResource) Schema(ctx context.Context, req resource.SchemaR
 				Computed:    true,
 				Description: "Current status of the network.",
 			},
+			"transaction_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the last channel config update applied by Terraform.",
+			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
 				Description: "Timestamp when the network was created.",
@@ -715,6 +727,89 @@ func (r *FabricNetworkResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+// plannedChannelAttributes are the attributes of an existing channel that
+// unsupportedConfigChanges inspects, because no config update operation can
+// change them, or only some of their changes.
+var plannedChannelAttributes = []string{
+	"description",
+	"orderer_organizations",
+	"external_orderer_orgs",
+	"consensus_type",
+	"smartbft_options",
+	"smartbft_consenters",
+	"application_policies",
+	"orderer_policies",
+	"channel_policies",
+}
+
+// ModifyPlan reports the changes that Update cannot apply to an existing
+// channel, so that they fail at plan time rather than during apply.
+func (r *FabricNetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to compare on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Values only known after apply, such as the ID of a new orderer
+	// organization, are checked again in Update
+	for _, name := range plannedChannelAttributes {
+		value, _, err := tftypes.WalkAttributePath(req.Plan.Raw, tftypes.NewAttributePath().WithAttributeName(name))
+		if v, ok := value.(tftypes.Value); err != nil || !ok || !v.IsFullyKnown() {
+			return
+		}
+	}
+
+	// Only the planned channel attributes are read, as the rest of the plan may
+	// hold unknown lists that the model cannot represent
+	var state, plan FabricNetworkResourceModel
+	resp.Diagnostics.Append(getPlannedChannelAttributes(ctx, req.State, &state)...)
+	resp.Diagnostics.Append(getPlannedChannelAttributes(ctx, req.Plan, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Description.ValueString() != state.Description.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Unsupported Change",
+			"The description of an existing Fabric network cannot be changed.",
+		)
+	}
+
+	var diags diag.Diagnostics
+	current := r.buildFabricNetworkConfig(ctx, &state, &diags)
+	desired := r.buildFabricNetworkConfig(ctx, &plan, &diags)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+	for _, name := range unsupportedConfigChanges(current, desired) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Unsupported Change",
+			fmt.Sprintf("%s cannot be changed on an existing channel: no channel config update operation covers it.", name),
+		)
+	}
+}
+
+// getPlannedChannelAttributes reads the attributes listed in
+// plannedChannelAttributes from a plan or state into data.
+func getPlannedChannelAttributes(ctx context.Context, src interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}, data *FabricNetworkResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(src.GetAttribute(ctx, path.Root("description"), &data.Description)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("orderer_organizations"), &data.OrdererOrganizations)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("external_orderer_orgs"), &data.ExternalOrdererOrgs)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("consensus_type"), &data.ConsensusType)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("smartbft_options"), &data.SmartBFTOptions)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("smartbft_consenters"), &data.SmartBFTConsenters)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("application_policies"), &data.ApplicationPolicies)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("orderer_policies"), &data.OrdererPolicies)...)
+	diags.Append(src.GetAttribute(ctx, path.Root("channel_policies"), &data.ChannelPolicies)...)
+	return diags
+}
+
 func (r *FabricNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FabricNetworkResourceModel
 
@@ -729,19 +824,19 @@ func (r *FabricNetworkResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	createReq := CreateFabricNetworkRequest{
+	createReq := createFabricNetworkRequest{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Config:      config,
 	}
 
-	body, err := r.client.DoRequest("POST", "/networks/fabric", createReq)
+	body, err := r.client.DoRequest(ctx, "POST", "/networks/fabric", createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Fabric network, got error: %s", err))
 		return
 	}
 
-	var networkResp FabricNetworkResponse
+	var networkResp models.HTTPNetworkResponse
 	if err := json.Unmarshal(body, &networkResp); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response: %s", err))
 		return
@@ -750,6 +845,7 @@ func (r *FabricNetworkResource) Create(ctx context.Context, req resource.CreateR
 	// Set computed values
 	data.ID = types.Int64Value(networkResp.ID)
 	data.Platform = types.StringValue("fabric")
+	data.TransactionID = types.StringNull()
 	if networkResp.Status != "" {
 		data.Status = types.StringValue(networkResp.Status)
 	}
@@ -779,22 +875,18 @@ func (r *FabricNetworkResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/fabric/%d", data.ID.ValueInt64())
-
-	body, err := r.client.DoRequest("GET", endpoint, nil)
+	got, err := r.client.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(data.ID.ValueInt64()), nil)
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fabric network, got error: %s", err))
 		return
 	}
-
-	var networkResp FabricNetworkResponse
-	if err := json.Unmarshal(body, &networkResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response: %s", err))
-		return
-	}
+	networkResp := got.Payload
 
 	// Update computed fields
-	// Note: API may not return all config fields, preserve from state
 	if networkResp.Status != "" {
 		data.Status = types.StringValue(networkResp.Status)
 	}
@@ -802,6 +894,21 @@ func (r *FabricNetworkResource) Read(ctx context.Context, req resource.ReadReque
 		data.UpdatedAt = types.StringValue(networkResp.UpdatedAt)
 	}
 
+	// Refresh the channel configuration from the live channel so that changes made
+	// outside Terraform (UI, update-config) show up as a diff
+	channelConfig, err := r.readChannelConfig(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Channel Configuration Unavailable",
+			fmt.Sprintf("Unable to read the current channel configuration, drift detection skipped: %s", err),
+		)
+	} else {
+		r.refreshFromChannelConfig(ctx, &data, channelConfig, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -824,38 +931,82 @@ func (r *FabricNetworkResource) Update(ctx context.Context, req resource.UpdateR
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	// Build the config object
-	config := r.buildFabricNetworkConfig(ctx, &data, &resp.Diagnostics)
-	if resp.Diagnostics.HasError() {
+	if data.Description.ValueString() != state.Description.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Unsupported Change",
+			"The description of an existing Fabric network cannot be changed.",
+		)
 		return
 	}
 
-	updateReq := UpdateFabricNetworkRequest{
-		Name:        data.Name.ValueString(),
-		Description: data.Description.ValueString(),
-		Config:      config,
+	current := r.buildFabricNetworkConfig(ctx, &state, &resp.Diagnostics)
+	desired := r.buildFabricNetworkConfig(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/fabric/%d", data.ID.ValueInt64())
+	orgs := r.resolvePeerOrganizations(ctx, current, desired, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	body, err := r.client.DoRequest("PUT", endpoint, updateReq)
+	operations, err := buildConfigUpdateOperations(current, desired, orgs)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Fabric network, got error: %s", err))
+		resp.Diagnostics.AddError("Unsupported Change", fmt.Sprintf("Unable to update Fabric network: %s", err))
 		return
 	}
 
-	var networkResp FabricNetworkResponse
-	if err := json.Unmarshal(body, &networkResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response: %s", err))
+	// Changes that do not touch the channel config (e.g. node_ids) need no update
+	data.TransactionID = state.TransactionID
+	if len(operations) > 0 {
+		signers := signingOrgIDs(current)
+		if len(signers) == 0 {
+			resp.Diagnostics.AddError(
+				"Unable To Sign Config Update",
+				"The channel config update must be signed by at least one Chainlaunch organization that is a member of the network.",
+			)
+			return
+		}
+
+		updateReq := &models.HTTPUpdateFabricNetworkRequest{
+			SigningOrgIds: signers,
+		}
+		for _, op := range operations {
+			updateReq.Operations = append(updateReq.Operations, &models.HTTPConfigUpdateOperationRequest{
+				Type:    &op.Type,
+				Payload: op.Payload,
+			})
+		}
+
+		params := fabric_networks.NewUpdateFabricChannelConfigParamsWithContext(ctx).WithID(data.ID.ValueInt64()).WithRequest(updateReq)
+		updated, err := r.client.API.FabricNetworks.UpdateFabricChannelConfig(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Fabric network channel configuration, got error: %s", err))
+			return
+		}
+		data.TransactionID = types.StringValue(updated.Payload.ID)
+	}
+
+	// Refresh status and updated_at
+	got, err := r.client.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(data.ID.ValueInt64()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fabric network, got error: %s", err))
 		return
 	}
+	networkResp := got.Payload
 
+	data.Status = state.Status
 	if networkResp.Status != "" {
 		data.Status = types.StringValue(networkResp.Status)
 	}
+	data.UpdatedAt = state.UpdatedAt
 	if networkResp.UpdatedAt != "" {
 		data.UpdatedAt = types.StringValue(networkResp.UpdatedAt)
 	}
+	data.Platform = state.Platform
+
+	r.applyDefaults(&data)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -868,9 +1019,7 @@ func (r *FabricNetworkResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/fabric/%d", data.ID.ValueInt64())
-
-	_, err := r.client.DoRequest("DELETE", endpoint, nil)
+	_, err := r.client.API.FabricNetworks.DeleteFabricNetwork(fabric_networks.NewDeleteFabricNetworkParamsWithContext(ctx).WithID(data.ID.ValueInt64()), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Fabric network, got error: %s", err))
 		return
@@ -1237,3 +1386,246 @@ func (r *FabricNetworkResource) applyDefaults(data *FabricNetworkResourceModel)
 		data.ConfigurePolicies = types.BoolValue(false)
 	}
 }
+
+// resolvePeerOrganizations looks up the Chainlaunch peer organizations that are
+// being added to or removed from the channel, keyed by organization ID.
+func (r *FabricNetworkResource) resolvePeerOrganizations(ctx context.Context, current, desired FabricNetworkConfig, diags *diag.Diagnostics) map[int64]*models.HandlerOrganizationResponse {
+	inCurrent := make(map[int64]bool, len(current.PeerOrganizations))
+	for _, org := range current.PeerOrganizations {
+		inCurrent[org.ID] = true
+	}
+	inDesired := make(map[int64]bool, len(desired.PeerOrganizations))
+	for _, org := range desired.PeerOrganizations {
+		inDesired[org.ID] = true
+	}
+
+	var changed []int64
+	for id := range inDesired {
+		if !inCurrent[id] {
+			changed = append(changed, id)
+		}
+	}
+	for id := range inCurrent {
+		if !inDesired[id] {
+			changed = append(changed, id)
+		}
+	}
+
+	orgs := make(map[int64]*models.HandlerOrganizationResponse, len(changed))
+	for _, id := range changed {
+		got, err := r.client.API.Organizations.GetFabricOrganization(organizations.NewGetFabricOrganizationParamsWithContext(ctx).WithID(id), nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read organization %d, got error: %s", id, err))
+			return nil
+		}
+		orgs[id] = got.Payload
+	}
+
+	return orgs
+}
+
+// readChannelConfig fetches and decodes the live channel configuration of the network.
+func (r *FabricNetworkResource) readChannelConfig(ctx context.Context, networkID int64) (*FabricChannelConfig, error) {
+	return readFabricChannelConfig(ctx, r.client, networkID)
+}
+
+// readFabricChannelConfig fetches and decodes the live channel configuration of a Fabric network.
+func readFabricChannelConfig(ctx context.Context, client *Client, networkID int64) (*FabricChannelConfig, error) {
+	got, err := client.API.FabricNetworks.GetFabricCurrentChannelConfig(fabric_networks.NewGetFabricCurrentChannelConfigParamsWithContext(ctx).WithID(networkID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if got.Payload == nil || got.Payload.Config == nil {
+		return nil, fmt.Errorf("channel config response is empty")
+	}
+
+	// The channel config is a free-form protobuf JSON document
+	raw, err := json.Marshal(got.Payload.Config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode channel config: %w", err)
+	}
+
+	return ParseFabricChannelConfig(raw)
+}
+
+// refreshFromChannelConfig overlays the live channel configuration onto the model.
+// Optional blocks and policy entries are only refreshed when they are already
+// tracked in state, so values the user never configured do not show up as drift.
+func (r *FabricNetworkResource) refreshFromChannelConfig(ctx context.Context, data *FabricNetworkResourceModel, cfg *FabricChannelConfig, diags *diag.Diagnostics) {
+	r.refreshPeerOrganizations(ctx, data, cfg, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if cfg.ConsensusType != "" {
+		data.ConsensusType = types.StringValue(cfg.ConsensusType)
+	}
+
+	if data.BatchSize != nil && cfg.BatchSize != nil {
+		data.BatchSize.MaxMessageCount = types.Int64Value(int64(cfg.BatchSize.MaxMessageCount))
+		data.BatchSize.AbsoluteMaxBytes = types.Int64Value(int64(cfg.BatchSize.AbsoluteMaxBytes))
+		data.BatchSize.PreferredMaxBytes = types.Int64Value(int64(cfg.BatchSize.PreferredMaxBytes))
+	}
+	data.BatchTimeout = refreshDuration(data.BatchTimeout, cfg.BatchTimeout)
+
+	if data.EtcdRaftOptions != nil && cfg.EtcdRaftOptions != nil {
+		opts := cfg.EtcdRaftOptions
+		data.EtcdRaftOptions.TickInterval = refreshDuration(data.EtcdRaftOptions.TickInterval, opts.TickInterval)
+		data.EtcdRaftOptions.ElectionTick = types.Int64Value(int64(opts.ElectionTick))
+		data.EtcdRaftOptions.HeartbeatTick = types.Int64Value(int64(opts.HeartbeatTick))
+		data.EtcdRaftOptions.MaxInflightBlocks = types.Int64Value(int64(opts.MaxInflightBlocks))
+		data.EtcdRaftOptions.SnapshotIntervalSize = types.Int64Value(int64(opts.SnapshotIntervalSize))
+	}
+
+	if data.SmartBFTOptions != nil && cfg.SmartBFTOptions != nil {
+		opts := cfg.SmartBFTOptions
+		m := data.SmartBFTOptions
+		m.RequestBatchMaxCount = types.Int64Value(int64(opts.RequestBatchMaxCount))
+		m.RequestBatchMaxBytes = types.Int64Value(int64(opts.RequestBatchMaxBytes))
+		m.RequestBatchMaxInterval = refreshDuration(m.RequestBatchMaxInterval, opts.RequestBatchMaxInterval)
+		m.RequestMaxBytes = types.Int64Value(int64(opts.RequestMaxBytes))
+		m.IncomingMessageBufferSize = types.Int64Value(int64(opts.IncomingMessageBufferSize))
+		m.RequestPoolSize = types.Int64Value(int64(opts.RequestPoolSize))
+		m.ViewChangeResendInterval = refreshDuration(m.ViewChangeResendInterval, opts.ViewChangeResendInterval)
+		m.ViewChangeTimeout = refreshDuration(m.ViewChangeTimeout, opts.ViewChangeTimeout)
+		m.LeaderHeartbeatCount = types.Int64Value(int64(opts.LeaderHeartbeatCount))
+		m.LeaderHeartbeatTimeout = refreshDuration(m.LeaderHeartbeatTimeout, opts.LeaderHeartbeatTimeout)
+		m.CollectTimeout = refreshDuration(m.CollectTimeout, opts.CollectTimeout)
+		m.SyncOnStart = types.BoolValue(opts.SyncOnStart)
+		m.SpeedUpViewChange = types.BoolValue(opts.SpeedUpViewChange)
+		if opts.LeaderRotation != "" {
+			m.LeaderRotation = types.StringValue(opts.LeaderRotation)
+		}
+		m.DecisionsPerLeader = types.Int64Value(int64(opts.DecisionsPerLeader))
+		m.RequestComplainTimeout = refreshDuration(m.RequestComplainTimeout, opts.RequestComplainTimeout)
+		m.RequestAutoRemoveTimeout = refreshDuration(m.RequestAutoRemoveTimeout, opts.RequestAutoRemoveTimeout)
+		m.RequestForwardTimeout = refreshDuration(m.RequestForwardTimeout, opts.RequestForwardTimeout)
+	}
+
+	data.ChannelCapabilities = refreshCapabilities(data.ChannelCapabilities, cfg.ChannelCapabilities)
+	data.ApplicationCapabilities = refreshCapabilities(data.ApplicationCapabilities, cfg.ApplicationCapabilities)
+	data.OrdererCapabilities = refreshCapabilities(data.OrdererCapabilities, cfg.OrdererCapabilities)
+
+	data.ApplicationPolicies = refreshPolicies(data.ApplicationPolicies, cfg.ApplicationPolicies)
+	data.OrdererPolicies = refreshPolicies(data.OrdererPolicies, cfg.OrdererPolicies)
+	data.ChannelPolicies = refreshPolicies(data.ChannelPolicies, cfg.ChannelPolicies)
+}
+
+// refreshPeerOrganizations reconciles peer_organizations with the MSPs that are
+// members of the channel's Application group. Organizations that left the channel
+// are dropped and Chainlaunch organizations that joined out-of-band are added
+// with an empty node list. Node IDs are not part of the channel config and are
+// kept from state.
+func (r *FabricNetworkResource) refreshPeerOrganizations(ctx context.Context, data *FabricNetworkResourceModel, cfg *FabricChannelConfig, diags *diag.Diagnostics) {
+	if cfg.ApplicationOrgs == nil {
+		return
+	}
+
+	members := make(map[string]bool, len(cfg.ApplicationOrgs))
+	for _, mspID := range cfg.ApplicationOrgs {
+		members[mspID] = true
+	}
+
+	// MSPs already accounted for in state, including external organizations
+	known := make(map[string]bool)
+	for _, ext := range data.ExternalPeerOrgs {
+		known[ext.MSPID.ValueString()] = true
+	}
+
+	var orgs []OrganizationConfigModel
+	for _, org := range data.PeerOrganizations {
+		got, err := r.client.API.Organizations.GetFabricOrganization(organizations.NewGetFabricOrganizationParamsWithContext(ctx).WithID(org.ID.ValueInt64()), nil)
+		if err != nil {
+			if IsNotFoundError(err) {
+				// The organization itself is gone; keep it so the plan surfaces the problem
+				orgs = append(orgs, org)
+				continue
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read organization %d, got error: %s", org.ID.ValueInt64(), err))
+			return
+		}
+
+		known[got.Payload.MspID] = true
+		if members[got.Payload.MspID] {
+			orgs = append(orgs, org)
+		}
+	}
+
+	for _, mspID := range cfg.ApplicationOrgs {
+		if known[mspID] {
+			continue
+		}
+
+		got, err := r.client.API.Organizations.GetFabricOrganizationByMspID(organizations.NewGetFabricOrganizationByMspIDParamsWithContext(ctx).WithMspid(mspID), nil)
+		if err != nil {
+			if IsNotFoundError(err) {
+				// Not managed by this Chainlaunch instance
+				continue
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to look up organization %s, got error: %s", mspID, err))
+			return
+		}
+
+		orgs = append(orgs, OrganizationConfigModel{
+			ID:      types.Int64Value(got.Payload.ID),
+			NodeIDs: []types.Int64{},
+		})
+	}
+
+	data.PeerOrganizations = orgs
+}
+
+// refreshCapabilities returns the live capabilities, keeping the configured
+// ordering when the set is unchanged. Unmanaged (null) lists stay null.
+func refreshCapabilities(current []types.String, live []string) []types.String {
+	if current == nil {
+		return nil
+	}
+
+	currentValues := make([]string, len(current))
+	for i, c := range current {
+		currentValues[i] = c.ValueString()
+	}
+	if sameStringSet(currentValues, live) {
+		return current
+	}
+
+	result := make([]types.String, len(live))
+	for i, c := range live {
+		result[i] = types.StringValue(c)
+	}
+	return result
+}
+
+// refreshPolicies returns the live policies of a managed (non-nil) policy map.
+// Policies tracked in state keep their configured attributes unless their type
+// or rule changed, policies removed from the channel are dropped so the plan
+// re-adds them, and policies only found on the channel are added so the plan
+// shows them. Unmanaged (null) maps stay null.
+func refreshPolicies(current map[string]FabricPolicyModel, live map[string]FabricPolicy) map[string]FabricPolicyModel {
+	if current == nil {
+		return nil
+	}
+
+	result := make(map[string]FabricPolicyModel, len(live))
+	for name, livePolicy := range live {
+		policy, ok := current[name]
+		if !ok {
+			result[name] = FabricPolicyModel{
+				Type:              types.StringValue(livePolicy.Type),
+				Rule:              types.StringValue(livePolicy.Rule),
+				SignatureOperator: types.StringNull(),
+				SignatureN:        types.Int64Null(),
+			}
+			continue
+		}
+		if !strings.EqualFold(policy.Type.ValueString(), livePolicy.Type) {
+			policy.Type = types.StringValue(livePolicy.Type)
+			policy.Rule = types.StringValue(livePolicy.Rule)
+		} else if !policyRulesEquivalent(policy.Rule.ValueString(), livePolicy.Rule) {
+			policy.Rule = types.StringValue(livePolicy.Rule)
+		}
+		result[name] = policy
+	}
+	return result
+}
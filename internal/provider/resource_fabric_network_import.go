@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &FabricNetworkImportResource{}
+var _ resource.ResourceWithImportState = &FabricNetworkImportResource{}
+var _ resource.ResourceWithValidateConfig = &FabricNetworkImportResource{}
+
+func NewFabricNetworkImportResource() resource.Resource {
+	return &FabricNetworkImportResource{}
+}
+
+// FabricNetworkImportResource registers an existing Fabric channel, run by
+// other parties, as a Chainlaunch network.
+type FabricNetworkImportResource struct {
+	client *Client
+}
+
+type FabricNetworkImportResourceModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	GenesisBlockBase64 types.String `tfsdk:"genesis_block_base64"`
+	GenesisBlockPath   types.String `tfsdk:"genesis_block_path"`
+	OrganizationID     types.Int64  `tfsdk:"organization_id"`
+	ChannelID          types.String `tfsdk:"channel_id"`
+	OrdererURL         types.String `tfsdk:"orderer_url"`
+	OrdererTLSCert     types.String `tfsdk:"orderer_tls_cert"`
+	Description        types.String `tfsdk:"description"`
+	Name               types.String `tfsdk:"name"`
+	Status             types.String `tfsdk:"status"`
+	MemberMSPIDs       types.List   `tfsdk:"member_msp_ids"`
+	OrdererMSPIDs      types.List   `tfsdk:"orderer_msp_ids"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+}
+
+func (r *FabricNetworkImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_network_import"
+}
+
+func (r *FabricNetworkImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports an existing Fabric channel, for example one run by another member of a consortium, as a Chainlaunch network. " +
+			"The channel is imported from its genesis or config block (genesis_block_base64 or genesis_block_path), " +
+			"or fetched from an orderer on behalf of a local organization (organization_id with channel_id, orderer_url and orderer_tls_cert). " +
+			"Note: Deleting this resource removes the network from Chainlaunch only - no channel config update is submitted and the organization stays a member of the channel.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The unique identifier of the imported network, usable wherever a Fabric network ID is expected.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"genesis_block_base64": schema.StringAttribute{
+				Optional:    true,
+				Description: "The genesis or config block of the channel, base64 encoded. Conflicts with genesis_block_path and organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"genesis_block_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file holding the genesis or config block of the channel. Conflicts with genesis_block_base64 and organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of a local organization that is a member of the channel. The config block is fetched from the orderer with its identity.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the channel to fetch. Required with organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"orderer_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "The orderer to fetch the config block from (host:port). Required with organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"orderer_tls_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "The PEM encoded TLS CA certificate of the orderer. Required with organization_id.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the network.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the network (channel name).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the network.",
+			},
+			"member_msp_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "MSP IDs of the application organizations in the channel configuration, decoded from the imported block.",
+			},
+			"orderer_msp_ids": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "MSP IDs of the orderer organizations in the channel configuration, decoded from the imported block.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the network was imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FabricNetworkImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FabricNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.GenesisBlockBase64.IsUnknown() || data.GenesisBlockPath.IsUnknown() || data.OrganizationID.IsUnknown() {
+		return
+	}
+
+	sources := 0
+	for _, set := range []bool{!data.GenesisBlockBase64.IsNull(), !data.GenesisBlockPath.IsNull(), !data.OrganizationID.IsNull()} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Channel Source",
+			"Exactly one of genesis_block_base64, genesis_block_path and organization_id must be set.",
+		)
+		return
+	}
+
+	fetchAttributes := map[string]types.String{
+		"channel_id":       data.ChannelID,
+		"orderer_url":      data.OrdererURL,
+		"orderer_tls_cert": data.OrdererTLSCert,
+	}
+	for _, name := range []string{"channel_id", "orderer_url", "orderer_tls_cert"} {
+		value := fetchAttributes[name]
+		switch {
+		case data.OrganizationID.IsNull() && !value.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination",
+				fmt.Sprintf("%s only applies when the channel is fetched with organization_id.", name))
+		case !data.OrganizationID.IsNull() && value.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Missing Attribute",
+				fmt.Sprintf("%s is required to fetch the channel with organization_id.", name))
+		}
+	}
+
+	if !data.GenesisBlockBase64.IsNull() {
+		if _, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data.GenesisBlockBase64.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("genesis_block_base64"), "Invalid Genesis Block",
+				fmt.Sprintf("genesis_block_base64 is not valid base64: %s", err))
+		}
+	}
+}
+
+func (r *FabricNetworkImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FabricNetworkImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FabricNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var networkID int64
+	if !data.OrganizationID.IsNull() {
+		params := fabric_networks.NewImportFabricNetworkWithOrgParamsWithContext(ctx).
+			WithRequest(&models.HTTPImportFabricNetworkWithOrgRequest{
+				OrganizationID: data.OrganizationID.ValueInt64Pointer(),
+				ChannelID:      data.ChannelID.ValueStringPointer(),
+				OrdererURL:     data.OrdererURL.ValueStringPointer(),
+				OrdererTLSCert: data.OrdererTLSCert.ValueStringPointer(),
+				Description:    data.Description.ValueString(),
+			})
+		imported, err := r.client.API.FabricNetworks.ImportFabricNetworkWithOrg(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import Fabric network, got error: %s", err))
+			return
+		}
+		networkID = imported.Payload.NetworkID
+	} else {
+		block := strings.TrimSpace(data.GenesisBlockBase64.ValueString())
+		if !data.GenesisBlockPath.IsNull() {
+			content, err := os.ReadFile(data.GenesisBlockPath.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Failed to read genesis block file", err.Error())
+				return
+			}
+			block = base64.StdEncoding.EncodeToString(content)
+		}
+
+		params := fabric_networks.NewImportFabricNetworkParamsWithContext(ctx).
+			WithRequest(&models.HTTPImportFabricNetworkRequest{
+				GenesisFile: &block,
+				Description: data.Description.ValueString(),
+			})
+		imported, err := r.client.API.FabricNetworks.ImportFabricNetwork(params, nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import Fabric network, got error: %s", err))
+			return
+		}
+		networkID = imported.Payload.NetworkID
+	}
+
+	data.ID = types.Int64Value(networkID)
+
+	got, err := r.client.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(networkID), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read imported Fabric network, got error: %s", err))
+		return
+	}
+
+	r.setNetwork(ctx, &data, got.Payload, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricNetworkImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FabricNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, err := r.client.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(data.ID.ValueInt64()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fabric network, got error: %s", err))
+		return
+	}
+
+	r.setNetwork(ctx, &data, got.Payload, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setNetwork copies the network and the MSPs of its channel configuration into data.
+func (r *FabricNetworkImportResource) setNetwork(ctx context.Context, data *FabricNetworkImportResourceModel, network *models.HTTPNetworkResponse, diags *diag.Diagnostics) {
+	data.Name = types.StringValue(network.Name)
+	data.Status = stringValueOrNull(network.Status)
+	data.CreatedAt = stringValueOrNull(network.CreatedAt)
+
+	memberMSPIDs, ordererMSPIDs, err := r.networkMSPIDs(ctx, data, network)
+	if err != nil {
+		diags.AddWarning(
+			"Channel Configuration Unavailable",
+			fmt.Sprintf("Unable to decode the channel configuration, member MSPs are not refreshed: %s", err),
+		)
+		if data.MemberMSPIDs.IsUnknown() {
+			data.MemberMSPIDs = types.ListNull(types.StringType)
+		}
+		if data.OrdererMSPIDs.IsUnknown() {
+			data.OrdererMSPIDs = types.ListNull(types.StringType)
+		}
+		return
+	}
+
+	members, d := types.ListValueFrom(ctx, types.StringType, memberMSPIDs)
+	diags.Append(d...)
+	orderers, d := types.ListValueFrom(ctx, types.StringType, ordererMSPIDs)
+	diags.Append(d...)
+	data.MemberMSPIDs = members
+	data.OrdererMSPIDs = orderers
+}
+
+// networkMSPIDs decodes the MSP IDs from the imported block or, for channels
+// fetched with organization_id, from the config block stored with the network.
+// The live channel configuration is only a fallback, as it can only be read
+// once a local peer or orderer can reach the channel.
+func (r *FabricNetworkImportResource) networkMSPIDs(ctx context.Context, data *FabricNetworkImportResourceModel, network *models.HTTPNetworkResponse) ([]string, []string, error) {
+	var blocks [][]byte
+	if !data.GenesisBlockBase64.IsNull() {
+		if block, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data.GenesisBlockBase64.ValueString())); err == nil {
+			blocks = append(blocks, block)
+		}
+	}
+	if !data.GenesisBlockPath.IsNull() {
+		// The file may be gone by the next refresh; the stored blocks are used then
+		if block, err := os.ReadFile(data.GenesisBlockPath.ValueString()); err == nil {
+			blocks = append(blocks, block)
+		}
+	}
+	for _, encoded := range []string{network.CurrentConfigBlock, network.GenesisBlock} {
+		if block, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(block) > 0 {
+			blocks = append(blocks, block)
+		}
+	}
+
+	for _, block := range blocks {
+		if members, orderers, err := decodeConfigBlockMSPIDs(block); err == nil {
+			return members, orderers, nil
+		}
+	}
+
+	channelConfig, err := readFabricChannelConfig(ctx, r.client, data.ID.ValueInt64())
+	if err != nil {
+		return nil, nil, err
+	}
+	return channelConfig.ApplicationOrgs, channelConfig.OrdererOrgs, nil
+}
+
+func (r *FabricNetworkImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The API has no update endpoint for imported networks; every attribute that can be
+	// configured forces a replacement, so there is nothing to send here.
+	var data FabricNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricNetworkImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FabricNetworkImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the Chainlaunch record is removed: the channel belongs to the consortium,
+	// so no config update is submitted and joined nodes keep their ledger
+	_, err := r.client.API.FabricNetworks.DeleteFabricNetwork(fabric_networks.NewDeleteFabricNetworkParamsWithContext(ctx).WithID(data.ID.ValueInt64()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Fabric network, got error: %s", err))
+		return
+	}
+}
+
+func (r *FabricNetworkImportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a numeric network ID, got %q.", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestFabricNetworkModifyPlanRejectsUnsupportedChanges checks that changes no
+// config update operation can apply are reported by the plan.
+func TestFabricNetworkModifyPlanRejectsUnsupportedChanges(t *testing.T) {
+	ctx := context.Background()
+
+	r := &FabricNetworkResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	network := func(description string, ordererOrgID int64, batchTimeout string) tftypes.Value {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := state.Set(ctx, &FabricNetworkResourceModel{
+			ID:                   types.Int64Value(1),
+			Name:                 types.StringValue("mychannel"),
+			Description:          types.StringValue(description),
+			PeerOrganizations:    []OrganizationConfigModel{{ID: types.Int64Value(2), NodeIDs: []types.Int64{types.Int64Value(3)}}},
+			OrdererOrganizations: []OrganizationConfigModel{{ID: types.Int64Value(ordererOrgID), NodeIDs: []types.Int64{types.Int64Value(4)}}},
+			ConsensusType:        types.StringValue("etcdraft"),
+			BatchTimeout:         types.StringValue(batchTimeout),
+		})
+		if diags.HasError() {
+			t.Fatalf("unable to build network: %v", diags)
+		}
+		return state.Raw
+	}
+
+	modifyPlan := func(plan tfsdk.Plan) *resource.ModifyPlanResponse {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: network("channel", 10, "2s")}
+		resp := &resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+		return resp
+	}
+
+	t.Run("supported change", func(t *testing.T) {
+		resp := modifyPlan(tfsdk.Plan{Schema: schemaResp.Schema, Raw: network("channel", 10, "5s")})
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected errors: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("unsupported changes", func(t *testing.T) {
+		resp := modifyPlan(tfsdk.Plan{Schema: schemaResp.Schema, Raw: network("renamed", 11, "2s")})
+		got := map[string]bool{}
+		for _, d := range resp.Diagnostics.Errors() {
+			if d, ok := d.(interface{ Path() path.Path }); ok {
+				got[d.Path().String()] = true
+			}
+		}
+		for _, want := range []string{"description", "orderer_organizations"} {
+			if !got[want] {
+				t.Errorf("expected an error on %s, got %v", want, resp.Diagnostics)
+			}
+		}
+	})
+
+	t.Run("unknown orderer organizations", func(t *testing.T) {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: network("channel", 10, "2s")}
+		listType := schemaResp.Schema.Attributes["orderer_organizations"].GetType().(types.ListType)
+		if diags := plan.SetAttribute(ctx, path.Root("orderer_organizations"), types.ListUnknown(listType.ElemType)); diags.HasError() {
+			t.Fatalf("unable to build plan: %v", diags)
+		}
+		if resp := modifyPlan(plan); resp.Diagnostics.HasError() {
+			t.Errorf("expected values only known after apply to be left to Update, got %v", resp.Diagnostics)
+		}
+	})
+}
+
+func TestRefreshPolicies(t *testing.T) {
+	live := map[string]FabricPolicy{
+		"Endorsement": {Type: "ImplicitMeta", Rule: "MAJORITY Endorsement"},
+		"Admins":      {Type: "ImplicitMeta", Rule: "ANY Admins"},
+	}
+
+	t.Run("unmanaged", func(t *testing.T) {
+		if got := refreshPolicies(nil, live); got != nil {
+			t.Errorf("expected an unmanaged map to stay null, got %v", got)
+		}
+	})
+
+	t.Run("only on the live channel", func(t *testing.T) {
+		current := map[string]FabricPolicyModel{
+			"Endorsement": {Type: types.StringValue("ImplicitMeta"), Rule: types.StringValue("majority endorsement")},
+			"Readers":     {Type: types.StringValue("ImplicitMeta"), Rule: types.StringValue("ANY Readers")},
+		}
+
+		got := refreshPolicies(current, live)
+		if len(got) != 2 {
+			t.Fatalf("expected the live policies, got %v", got)
+		}
+		if rule := got["Endorsement"].Rule.ValueString(); rule != "majority endorsement" {
+			t.Errorf("expected the equivalent configured rule to be kept, got %q", rule)
+		}
+		if _, ok := got["Readers"]; ok {
+			t.Error("expected the policy removed from the channel to be dropped")
+		}
+		admins, ok := got["Admins"]
+		if !ok {
+			t.Fatal("expected the policy only found on the channel to be added")
+		}
+		if admins.Type.ValueString() != "ImplicitMeta" || admins.Rule.ValueString() != "ANY Admins" {
+			t.Errorf("unexpected Admins policy: %+v", admins)
+		}
+	})
+}
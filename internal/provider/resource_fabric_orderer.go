@@ -5,8 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,10 +14,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricOrdererResource{}
 var _ resource.ResourceWithImportState = &FabricOrdererResource{}
+var _ resource.ResourceWithValidateConfig = &FabricOrdererResource{}
 
 func NewFabricOrdererResource() resource.Resource {
 	return &FabricOrdererResource{}
@@ -28,24 +32,27 @@ type FabricOrdererResource struct {
 }
 
 type FabricOrdererResourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	OrganizationID          types.Int64  `tfsdk:"organization_id"`
-	MspID                   types.String `tfsdk:"msp_id"`
-	Mode                    types.String `tfsdk:"mode"`
-	Version                 types.String `tfsdk:"version"`
-	ListenAddress           types.String `tfsdk:"listen_address"`
-	AdminAddress            types.String `tfsdk:"admin_address"`
-	OperationsListenAddress types.String `tfsdk:"operations_listen_address"`
-	ExternalEndpoint        types.String `tfsdk:"external_endpoint"`
-	DomainNames             types.List   `tfsdk:"domain_names"`
-	CertificateExpiration   types.Int64  `tfsdk:"certificate_expiration"`
-	AutoRenewalEnabled      types.Bool   `tfsdk:"auto_renewal_enabled"`
-	AutoRenewalDays         types.Int64  `tfsdk:"auto_renewal_days"`
-	Environment             types.Map    `tfsdk:"environment"`
-	Status                  types.String `tfsdk:"status"`
-	CreatedAt               types.String `tfsdk:"created_at"`
-	UpdatedAt               types.String `tfsdk:"updated_at"`
+	ID                      types.String   `tfsdk:"id"`
+	Name                    types.String   `tfsdk:"name"`
+	OrganizationID          types.Int64    `tfsdk:"organization_id"`
+	MspID                   types.String   `tfsdk:"msp_id"`
+	Mode                    types.String   `tfsdk:"mode"`
+	Version                 types.String   `tfsdk:"version"`
+	ListenAddress           types.String   `tfsdk:"listen_address"`
+	AdminAddress            types.String   `tfsdk:"admin_address"`
+	OperationsListenAddress types.String   `tfsdk:"operations_listen_address"`
+	ExternalEndpoint        types.String   `tfsdk:"external_endpoint"`
+	DomainNames             types.List     `tfsdk:"domain_names"`
+	CertificateExpiration   types.Int64    `tfsdk:"certificate_expiration"`
+	AutoRenewalEnabled      types.Bool     `tfsdk:"auto_renewal_enabled"`
+	AutoRenewalDays         types.Int64    `tfsdk:"auto_renewal_days"`
+	Environment             types.Map      `tfsdk:"environment"`
+	DesiredState            types.String   `tfsdk:"desired_state"`
+	RestartTriggers         types.Map      `tfsdk:"restart_triggers"`
+	Status                  types.String   `tfsdk:"status"`
+	CreatedAt               types.String   `tfsdk:"created_at"`
+	UpdatedAt               types.String   `tfsdk:"updated_at"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *FabricOrdererResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -87,14 +94,11 @@ func (r *FabricOrdererResource) Schema(ctx context.Context, req resource.SchemaR
 			},
 			"mode": schema.StringAttribute{
 				Required:    true,
-				Description: "The deployment mode: 'docker' or 'service'.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "The deployment mode: 'docker' or 'service'. Changing it updates the node in place.",
 			},
 			"version": schema.StringAttribute{
 				Required:    true,
-				Description: "Fabric version to use (e.g., 2.2.0, 2.5.0, 2.5.9).",
+				Description: "Fabric version to use (e.g., 2.2.0, 2.5.0, 2.5.9). Changing it upgrades the node in place and restarts it.",
 			},
 			"listen_address": schema.StringAttribute{
 				Required:    true,
@@ -134,6 +138,8 @@ func (r *FabricOrdererResource) Schema(ctx context.Context, req resource.SchemaR
 				Optional:    true,
 				Description: "Environment variables for the orderer container.",
 			},
+			"desired_state":    desiredStateAttribute(),
+			"restart_triggers": restartTriggersAttribute(),
 			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "The current status of the orderer node.",
@@ -147,6 +153,22 @@ func (r *FabricOrdererResource) Schema(ctx context.Context, req resource.SchemaR
 				Description: "The timestamp when the orderer was last updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *FabricOrdererResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var desiredState types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("desired_state"), &desiredState)...)
+	if err := validateDesiredState(desiredState); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("desired_state"), "Invalid Desired State", err.Error())
 	}
 }
 
@@ -175,82 +197,58 @@ func (r *FabricOrdererResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	// Build the FabricOrdererConfig
-	ordererConfig := map[string]interface{}{
-		"name":           data.Name.ValueString(),
-		"organizationId": data.OrganizationID.ValueInt64(),
-		"mspId":          data.MspID.ValueString(),
-		"type":           "fabric-orderer",
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Add optional fields
-	if !data.Mode.IsNull() {
-		ordererConfig["mode"] = data.Mode.ValueString()
-	}
-	if !data.Version.IsNull() {
-		ordererConfig["version"] = data.Version.ValueString()
-	}
-	if !data.ListenAddress.IsNull() {
-		ordererConfig["listenAddress"] = data.ListenAddress.ValueString()
-	}
-	if !data.AdminAddress.IsNull() {
-		ordererConfig["adminAddress"] = data.AdminAddress.ValueString()
-	}
-	if !data.OperationsListenAddress.IsNull() {
-		ordererConfig["operationsListenAddress"] = data.OperationsListenAddress.ValueString()
-	}
-	if !data.ExternalEndpoint.IsNull() {
-		ordererConfig["externalEndpoint"] = data.ExternalEndpoint.ValueString()
-	}
-	if !data.CertificateExpiration.IsNull() {
-		ordererConfig["certificateExpiration"] = data.CertificateExpiration.ValueInt64()
-	}
-	if !data.AutoRenewalEnabled.IsNull() {
-		ordererConfig["autoRenewalEnabled"] = data.AutoRenewalEnabled.ValueBool()
-	}
-	if !data.AutoRenewalDays.IsNull() {
-		ordererConfig["autoRenewalDays"] = data.AutoRenewalDays.ValueInt64()
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Build the FabricOrdererConfig
+	ordererConfig := &models.TypesFabricOrdererConfig{
+		Name:                    data.Name.ValueStringPointer(),
+		OrganizationID:          data.OrganizationID.ValueInt64Pointer(),
+		MspID:                   data.MspID.ValueStringPointer(),
+		Type:                    "fabric-orderer",
+		Mode:                    data.Mode.ValueString(),
+		Version:                 data.Version.ValueString(),
+		ListenAddress:           data.ListenAddress.ValueString(),
+		AdminAddress:            data.AdminAddress.ValueString(),
+		OperationsListenAddress: data.OperationsListenAddress.ValueString(),
+		ExternalEndpoint:        data.ExternalEndpoint.ValueString(),
+		CertificateExpiration:   data.CertificateExpiration.ValueInt64(),
+		AutoRenewalEnabled:      data.AutoRenewalEnabled.ValueBool(),
+		AutoRenewalDays:         data.AutoRenewalDays.ValueInt64(),
 	}
 
-	// Handle domain names list
 	if !data.DomainNames.IsNull() {
-		var domainNames []string
-		diags := data.DomainNames.ElementsAs(ctx, &domainNames, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		ordererConfig["domainNames"] = domainNames
+		resp.Diagnostics.Append(data.DomainNames.ElementsAs(ctx, &ordererConfig.DomainNames, false)...)
 	}
-
-	// Handle environment variables map
 	if !data.Environment.IsNull() {
-		var envVars map[string]string
-		diags := data.Environment.ElementsAs(ctx, &envVars, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		ordererConfig["env"] = envVars
+		resp.Diagnostics.Append(data.Environment.ElementsAs(ctx, &ordererConfig.Env, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Build the CreateNodeRequest
-	createReq := map[string]interface{}{
-		"name":               data.Name.ValueString(),
-		"blockchainPlatform": "FABRIC",
-		"fabricOrderer":      ordererConfig,
+	createReq := createNodeRequest{
+		Name:               data.Name.ValueString(),
+		BlockchainPlatform: models.TypesBlockchainPlatformFABRIC,
+		FabricOrderer:      ordererConfig,
 	}
 
-	body, err := r.client.DoRequest("POST", "/nodes", createReq)
+	body, err := r.client.DoRequest(ctx, "POST", "/nodes", createReq)
 
 	// Parse response - even if there's an error, the response might contain node data
-	var nodeResp OrdererNodeResponse
+	var nodeResp models.HTTPNodeResponse
 	var errorResponse struct {
 		Message string `json:"message"`
 		Data    struct {
-			Node   *OrdererNodeResponse `json:"node"`
-			NodeID int64                `json:"node_id"`
-			Stage  string               `json:"stage"`
+			Node   *models.HTTPNodeResponse `json:"node"`
+			NodeID int64                    `json:"node_id"`
+			Stage  string                   `json:"stage"`
 		} `json:"data"`
 	}
 
@@ -323,6 +321,16 @@ func (r *FabricOrdererResource) Create(ctx context.Context, req resource.CreateR
 		data.Status = types.StringValue("RUNNING")
 	}
 
+	// Park the node when it should not run
+	if data.DesiredState.ValueString() == desiredStateStopped {
+		stopped, err := reconcileNodeState(ctx, r.client, nodeResp.ID, desiredStateStopped, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to stop fabric orderer, got error: %s", err))
+		} else {
+			data.Status = types.StringValue(stopped.Status)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -334,7 +342,13 @@ func (r *FabricOrdererResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		// If resource not found (404), remove from state
 		if IsNotFoundError(err) {
@@ -344,18 +358,14 @@ func (r *FabricOrdererResource) Read(ctx context.Context, req resource.ReadReque
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fabric orderer, got error: %s", err))
 		return
 	}
-
-	var nodeResp OrdererNodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse orderer response, got error: %s", err))
-		return
-	}
+	nodeResp := got.Payload
 
 	// Update state from API response
 	// Note: Some fields might not be returned by API, preserve them from state
 	if nodeResp.Status != "" {
 		data.Status = types.StringValue(nodeResp.Status)
 	}
+	data.DesiredState = observedDesiredState(nodeResp.Status, data.DesiredState)
 	if nodeResp.CreatedAt != "" {
 		data.CreatedAt = types.StringValue(nodeResp.CreatedAt)
 	}
@@ -363,6 +373,28 @@ func (r *FabricOrdererResource) Read(ctx context.Context, req resource.ReadReque
 		data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
 	}
 
+	// Refresh the configuration that can be updated in place, so that changes
+	// made outside Terraform show up as drift
+	if orderer := nodeResp.FabricOrderer; orderer != nil {
+		refreshString(&data.MspID, orderer.MspID)
+		if orderer.OrganizationID != 0 {
+			data.OrganizationID = types.Int64Value(orderer.OrganizationID)
+		}
+		refreshString(&data.Mode, orderer.Mode)
+		refreshString(&data.Version, orderer.Version)
+		refreshString(&data.ListenAddress, orderer.ListenAddress)
+		refreshString(&data.AdminAddress, orderer.AdminAddress)
+		refreshString(&data.OperationsListenAddress, orderer.OperationsAddress)
+		refreshString(&data.ExternalEndpoint, orderer.ExternalEndpoint)
+
+		domainNames, diags := refreshStringList(ctx, data.DomainNames, orderer.DomainNames)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		data.DomainNames = domainNames
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -382,93 +414,99 @@ func (r *FabricOrdererResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	// Build the FabricOrdererConfig for update
-	ordererConfig := map[string]interface{}{
-		"name":           data.Name.ValueString(),
-		"organizationId": data.OrganizationID.ValueInt64(),
-		"mspId":          data.MspID.ValueString(),
-		"type":           "fabric-orderer",
-	}
-
-	// Add optional fields
-	if !data.Mode.IsNull() {
-		ordererConfig["mode"] = data.Mode.ValueString()
-	}
-	if !data.Version.IsNull() {
-		ordererConfig["version"] = data.Version.ValueString()
-	}
-	if !data.ListenAddress.IsNull() {
-		ordererConfig["listenAddress"] = data.ListenAddress.ValueString()
-	}
-	if !data.AdminAddress.IsNull() {
-		ordererConfig["adminAddress"] = data.AdminAddress.ValueString()
-	}
-	if !data.OperationsListenAddress.IsNull() {
-		ordererConfig["operationsListenAddress"] = data.OperationsListenAddress.ValueString()
-	}
-	if !data.ExternalEndpoint.IsNull() {
-		ordererConfig["externalEndpoint"] = data.ExternalEndpoint.ValueString()
-	}
-	if !data.CertificateExpiration.IsNull() {
-		ordererConfig["certificateExpiration"] = data.CertificateExpiration.ValueInt64()
-	}
-	if !data.AutoRenewalEnabled.IsNull() {
-		ordererConfig["autoRenewalEnabled"] = data.AutoRenewalEnabled.ValueBool()
-	}
-	if !data.AutoRenewalDays.IsNull() {
-		ordererConfig["autoRenewalDays"] = data.AutoRenewalDays.ValueInt64()
+	// Only send the node configuration when it changed; desired_state and
+	// restart_triggers alone are applied below
+	configChanged, err := nodeConfigChanged(req)
+	if err != nil {
+		resp.Diagnostics.AddError("Plan Error", fmt.Sprintf("Unable to compare the planned fabric orderer configuration, got error: %s", err))
+		return
 	}
+	data.Status = state.Status
+	data.UpdatedAt = state.UpdatedAt
+
+	if configChanged {
+		// Build the FabricOrdererConfig for update
+		ordererConfig := &updateFabricOrdererRequest{
+			HTTPUpdateFabricOrdererRequest: models.HTTPUpdateFabricOrdererRequest{
+				Mode:                    data.Mode.ValueString(),
+				Version:                 data.Version.ValueString(),
+				ListenAddress:           data.ListenAddress.ValueString(),
+				AdminAddress:            data.AdminAddress.ValueString(),
+				OperationsListenAddress: data.OperationsListenAddress.ValueString(),
+				ExternalEndpoint:        data.ExternalEndpoint.ValueString(),
+			},
+		}
 
-	// Handle domain names list
-	if !data.DomainNames.IsNull() {
-		var domainNames []string
-		diags := data.DomainNames.ElementsAs(ctx, &domainNames, false)
-		resp.Diagnostics.Append(diags...)
+		// Empty collections rather than null, so that removing domain_names or
+		// environment clears them on the node
+		ordererConfig.DomainNames = []string{}
+		if !data.DomainNames.IsNull() {
+			resp.Diagnostics.Append(data.DomainNames.ElementsAs(ctx, &ordererConfig.DomainNames, false)...)
+		}
+		ordererConfig.Env = map[string]string{}
+		if !data.Environment.IsNull() {
+			resp.Diagnostics.Append(data.Environment.ElementsAs(ctx, &ordererConfig.Env, false)...)
+		}
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		ordererConfig["domainNames"] = domainNames
-	}
 
-	// Handle environment variables map
-	if !data.Environment.IsNull() {
-		var envVars map[string]string
-		diags := data.Environment.ElementsAs(ctx, &envVars, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		// Build the update request
+		updateReq := updateNodeRequest{
+			Name:               data.Name.ValueString(),
+			BlockchainPlatform: models.TypesBlockchainPlatformFABRIC,
+			FabricOrderer:      ordererConfig,
+		}
+
+		body, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update fabric orderer, got error: %s", err))
+			return
+		}
+
+		var nodeResp models.HTTPNodeResponse
+		if err := json.Unmarshal(body, &nodeResp); err != nil {
+			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse orderer response, got error: %s", err))
 			return
 		}
-		ordererConfig["env"] = envVars
-	}
 
-	// Build the update request
-	updateReq := map[string]interface{}{
-		"name":               data.Name.ValueString(),
-		"blockchainPlatform": "FABRIC",
-		"fabricOrderer":      ordererConfig,
+		// Update state from response
+		if nodeResp.Status != "" {
+			data.Status = types.StringValue(nodeResp.Status)
+		}
+		if nodeResp.UpdatedAt != "" {
+			data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
+		}
 	}
 
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update fabric orderer, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var nodeResp OrdererNodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse orderer response, got error: %s", err))
+	// A running node is restarted to pick up a new configuration, such as a
+	// version upgrade, and the update only completes once it is RUNNING again
+	node, err := reconcileNodeState(ctx, r.client, id, data.DesiredState.ValueString(), configChanged || restartRequested(state.RestartTriggers, data.RestartTriggers))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply desired_state to fabric orderer, got error: %s", err))
 		return
 	}
-
-	// Update state from response
-	if nodeResp.Status != "" {
-		data.Status = types.StringValue(nodeResp.Status)
-	}
-	if nodeResp.UpdatedAt != "" {
-		data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
+	data.Status = types.StringValue(node.Status)
+	if node.UpdatedAt != "" {
+		data.UpdatedAt = types.StringValue(node.UpdatedAt)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -482,7 +520,22 @@ func (r *FabricOrdererResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Nodes.DeleteNode(nodes.NewDeleteNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete fabric orderer, got error: %s", err))
 		return
@@ -495,41 +548,18 @@ func (r *FabricOrdererResource) ImportState(ctx context.Context, req resource.Im
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// OrdererNodeResponse represents the API response when creating/reading an orderer node
-type OrdererNodeResponse struct {
-	ID            int64                  `json:"id"`
-	Name          string                 `json:"name"`
-	Platform      string                 `json:"blockchainPlatform,omitempty"`
-	Status        string                 `json:"status,omitempty"`
-	CreatedAt     string                 `json:"createdAt,omitempty"`
-	UpdatedAt     string                 `json:"updatedAt,omitempty"`
-	Config        map[string]interface{} `json:"config,omitempty"`
-	FabricOrderer map[string]interface{} `json:"fabricOrderer,omitempty"`
-}
-
-// waitForOrdererRunning polls the node status until it reaches RUNNING state or timeout
+// waitForOrdererRunning polls the node status until it reaches RUNNING state or ctx expires
 func (r *FabricOrdererResource) waitForOrdererRunning(ctx context.Context, ordererID int64) error {
-	maxAttempts := 60 // 60 attempts
-	delaySeconds := 2 // 2 seconds between attempts (total 120 seconds / 2 minutes)
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if context is cancelled
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("context cancelled while waiting for orderer to reach RUNNING state")
-		default:
-		}
-
+	for {
 		// Get current node status
-		body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%d", ordererID), nil)
+		got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(ordererID), nil)
 		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out waiting for orderer to reach RUNNING state: %w", ctx.Err())
+			}
 			return fmt.Errorf("failed to check orderer status: %w", err)
 		}
-
-		var nodeResp OrdererNodeResponse
-		if err := json.Unmarshal(body, &nodeResp); err != nil {
-			return fmt.Errorf("failed to parse orderer status response: %w", err)
-		}
+		nodeResp := got.Payload
 
 		// Check if orderer is running
 		if nodeResp.Status == "RUNNING" {
@@ -542,10 +572,8 @@ func (r *FabricOrdererResource) waitForOrdererRunning(ctx context.Context, order
 		}
 
 		// Not ready yet, wait and try again
-		if attempt < maxAttempts {
-			time.Sleep(time.Duration(delaySeconds) * time.Second)
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return fmt.Errorf("timed out waiting for orderer to reach RUNNING state (last status %q): %w", nodeResp.Status, err)
 		}
 	}
-
-	return fmt.Errorf("orderer did not reach RUNNING state after %d attempts (%d seconds)", maxAttempts, maxAttempts*delaySeconds)
 }
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -11,6 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -108,37 +110,25 @@ func (r *OrganizationResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Use msp_id as the name
-	createReq := CreateOrganizationRequest{
-		Name:        data.MSPID.ValueString(),
-		MSPID:       data.MSPID.ValueString(),
+	createReq := &models.HandlerCreateOrganizationRequest{
+		Name:        data.MSPID.ValueStringPointer(),
+		MspID:       data.MSPID.ValueStringPointer(),
 		Description: data.Description.ValueString(),
 	}
 
 	if !data.ProviderID.IsNull() {
-		createReq.ProviderID = int(data.ProviderID.ValueInt64())
+		createReq.ProviderID = data.ProviderID.ValueInt64()
 	}
 
-	body, err := r.client.DoRequest("POST", "/organizations", createReq)
+	created, err := r.client.API.Organizations.CreateFabricOrganization(organizations.NewCreateFabricOrganizationParamsWithContext(ctx).WithRequest(createReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization, got error: %s", err))
 		return
 	}
-
-	// Try to parse as wrapped response first
-	var wrappedResp struct {
-		Data Organization `json:"data"`
-	}
-	var org Organization
-
-	if err := json.Unmarshal(body, &wrappedResp); err == nil && wrappedResp.Data.ID != 0 {
-		org = wrappedResp.Data
-	} else if err := json.Unmarshal(body, &org); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse organization response, got error: %s\nResponse body: %s", err, string(body)))
-		return
-	}
+	org := created.Payload
 
 	data.ID = types.StringValue(fmt.Sprintf("%d", org.ID))
-	data.MSPID = types.StringValue(org.MSPID)
+	data.MSPID = types.StringValue(org.MspID)
 	if org.Description != "" {
 		data.Description = types.StringValue(org.Description)
 	}
@@ -161,19 +151,24 @@ func (r *OrganizationResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/organizations/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var org Organization
-	if err := json.Unmarshal(body, &org); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse organization response, got error: %s", err))
+	got, err := r.client.API.Organizations.GetFabricOrganization(organizations.NewGetFabricOrganizationParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
 		return
 	}
+	org := got.Payload
 
-	data.MSPID = types.StringValue(org.MSPID)
+	data.MSPID = types.StringValue(org.MspID)
 	if org.Description != "" {
 		data.Description = types.StringValue(org.Description)
 	}
@@ -206,26 +201,24 @@ func (r *OrganizationResource) Update(ctx context.Context, req resource.UpdateRe
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	// Use msp_id as the name
-	updateReq := CreateOrganizationRequest{
-		Name:        data.MSPID.ValueString(),
-		MSPID:       data.MSPID.ValueString(),
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	updateReq := &models.HandlerUpdateOrganizationRequest{
 		Description: data.Description.ValueString(),
 	}
 
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/organizations/%s", data.ID.ValueString()), updateReq)
+	updated, err := r.client.API.Organizations.UpdateFabricOrganization(organizations.NewUpdateFabricOrganizationParamsWithContext(ctx).WithID(id).WithRequest(updateReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization, got error: %s", err))
 		return
 	}
+	org := updated.Payload
 
-	var org Organization
-	if err := json.Unmarshal(body, &org); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse organization response, got error: %s", err))
-		return
-	}
-
-	data.MSPID = types.StringValue(org.MSPID)
+	data.MSPID = types.StringValue(org.MspID)
 	if org.Description != "" {
 		data.Description = types.StringValue(org.Description)
 	}
@@ -245,7 +238,13 @@ func (r *OrganizationResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/organizations/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Organizations.DeleteFabricOrganization(organizations.NewDeleteFabricOrganizationParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organization, got error: %s", err))
 		return
@@ -0,0 +1,483 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/organizations"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+// Import source types accepted by POST /organizations/import
+const (
+	organizationImportSourceRaw    = "raw"
+	organizationImportSourceVault  = "vault"
+	organizationImportSourceAWSKMS = "aws_kms"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FabricOrganizationImportResource{}
+var _ resource.ResourceWithValidateConfig = &FabricOrganizationImportResource{}
+
+func NewFabricOrganizationImportResource() resource.Resource {
+	return &FabricOrganizationImportResource{}
+}
+
+// FabricOrganizationImportResource brings an existing Fabric MSP under
+// Chainlaunch management by importing its CA certificates and keys.
+type FabricOrganizationImportResource struct {
+	client *Client
+}
+
+// FabricOrganizationImportResourceModel describes the resource data model.
+type FabricOrganizationImportResourceModel struct {
+	ID              types.String                   `tfsdk:"id"`
+	MSPID           types.String                   `tfsdk:"msp_id"`
+	Description     types.String                   `tfsdk:"description"`
+	ProviderID      types.Int64                    `tfsdk:"provider_id"`
+	SourceType      types.String                   `tfsdk:"source_type"`
+	RawImport       *OrganizationRawImportModel    `tfsdk:"raw_import"`
+	VaultImport     *OrganizationVaultImportModel  `tfsdk:"vault_import"`
+	AWSKMSImport    *OrganizationAWSKMSImportModel `tfsdk:"aws_kms_import"`
+	SignCertificate types.String                   `tfsdk:"sign_certificate"`
+	TLSCertificate  types.String                   `tfsdk:"tls_certificate"`
+	SignPublicKey   types.String                   `tfsdk:"sign_public_key"`
+	TLSPublicKey    types.String                   `tfsdk:"tls_public_key"`
+	CreatedAt       types.String                   `tfsdk:"created_at"`
+	UpdatedAt       types.String                   `tfsdk:"updated_at"`
+}
+
+// OrganizationRawImportModel holds PEM encoded CA certificates and keys.
+type OrganizationRawImportModel struct {
+	SignCACert       types.String `tfsdk:"sign_ca_cert"`
+	SignCAPrivateKey types.String `tfsdk:"sign_ca_private_key"`
+	TLSCACert        types.String `tfsdk:"tls_ca_cert"`
+	TLSCAPrivateKey  types.String `tfsdk:"tls_ca_private_key"`
+}
+
+// OrganizationVaultImportModel holds the Vault paths of the CA certificates and keys.
+type OrganizationVaultImportModel struct {
+	SignCAPath types.String `tfsdk:"sign_ca_path"`
+	TLSCAPath  types.String `tfsdk:"tls_ca_path"`
+}
+
+// OrganizationAWSKMSImportModel holds CA certificates whose keys live in AWS KMS.
+type OrganizationAWSKMSImportModel struct {
+	SignCACert  types.String `tfsdk:"sign_ca_cert"`
+	SignCAKeyID types.String `tfsdk:"sign_ca_key_id"`
+	TLSCACert   types.String `tfsdk:"tls_ca_cert"`
+	TLSCAKeyID  types.String `tfsdk:"tls_ca_key_id"`
+}
+
+func (r *FabricOrganizationImportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fabric_organization_import"
+}
+
+func (r *FabricOrganizationImportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports an existing Hyperledger Fabric organization (MSP) into Chainlaunch from PEM encoded certificates and keys, " +
+			"from Vault paths or from certificates whose keys are held in AWS KMS. Exactly one of raw_import, vault_import and " +
+			"aws_kms_import must be set. If the certificates held by Chainlaunch stop matching the imported ones, the next plan " +
+			"replaces the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"msp_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The MSP ID of the imported organization. This will be used as the organization name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "A description of the organization.",
+			},
+			"provider_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the key provider that stores the imported keys.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"source_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The import source sent to the API: raw, vault or aws_kms.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"raw_import": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificates and keys.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"sign_ca_cert": schema.StringAttribute{
+						Required:    true,
+						Description: "PEM encoded signing CA certificate.",
+					},
+					"sign_ca_private_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "PEM encoded signing CA private key. Without it Chainlaunch cannot issue new identities for the organization.",
+					},
+					"tls_ca_cert": schema.StringAttribute{
+						Required:    true,
+						Description: "PEM encoded TLS CA certificate.",
+					},
+					"tls_ca_private_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "PEM encoded TLS CA private key.",
+					},
+				},
+			},
+			"vault_import": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "Vault paths holding the CA certificates and keys, read through the key provider.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"sign_ca_path": schema.StringAttribute{
+						Required:    true,
+						Description: "Vault path of the signing CA certificate and key.",
+					},
+					"tls_ca_path": schema.StringAttribute{
+						Required:    true,
+						Description: "Vault path of the TLS CA certificate and key.",
+					},
+				},
+			},
+			"aws_kms_import": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "CA certificates whose private keys are held in AWS KMS.",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"sign_ca_cert": schema.StringAttribute{
+						Required:    true,
+						Description: "PEM encoded signing CA certificate.",
+					},
+					"sign_ca_key_id": schema.StringAttribute{
+						Required:    true,
+						Description: "KMS key ID or ARN of the signing CA key.",
+					},
+					"tls_ca_cert": schema.StringAttribute{
+						Required:    true,
+						Description: "PEM encoded TLS CA certificate.",
+					},
+					"tls_ca_key_id": schema.StringAttribute{
+						Required:    true,
+						Description: "KMS key ID or ARN of the TLS CA key.",
+					},
+				},
+			},
+			"sign_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The signing CA certificate held by Chainlaunch.",
+			},
+			"tls_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The TLS CA certificate held by Chainlaunch.",
+			},
+			"sign_public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key of the signing CA.",
+			},
+			"tls_public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key of the TLS CA.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the organization was imported.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the organization was last updated.",
+			},
+		},
+	}
+}
+
+func (r *FabricOrganizationImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	sources := 0
+	for _, attribute := range []string{"raw_import", "vault_import", "aws_kms_import"} {
+		var source types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attribute), &source)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if source.IsUnknown() {
+			// Not known until apply, for example when built from another resource
+			return
+		}
+		if !source.IsNull() {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Import Source",
+			"Exactly one of raw_import, vault_import and aws_kms_import must be set.",
+		)
+	}
+}
+
+func (r *FabricOrganizationImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FabricOrganizationImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FabricOrganizationImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Use msp_id as the name, as chainlaunch_fabric_organization does
+	importReq := importOrganizationRequest{
+		Name:        data.MSPID.ValueString(),
+		MspID:       data.MSPID.ValueString(),
+		Description: data.Description.ValueString(),
+		ProviderID:  data.ProviderID.ValueInt64(),
+	}
+
+	switch {
+	case data.RawImport != nil:
+		importReq.SourceType = organizationImportSourceRaw
+		importReq.RawImport = &models.HandlerRawImportData{
+			SignCaCert:       data.RawImport.SignCACert.ValueStringPointer(),
+			SignCaPrivateKey: data.RawImport.SignCAPrivateKey.ValueString(),
+			TLSCaCert:        data.RawImport.TLSCACert.ValueStringPointer(),
+			TLSCaPrivateKey:  data.RawImport.TLSCAPrivateKey.ValueString(),
+		}
+	case data.VaultImport != nil:
+		importReq.SourceType = organizationImportSourceVault
+		importReq.VaultImport = &models.HandlerVaultImportData{
+			SignCaPath: data.VaultImport.SignCAPath.ValueStringPointer(),
+			TLSCaPath:  data.VaultImport.TLSCAPath.ValueStringPointer(),
+		}
+	case data.AWSKMSImport != nil:
+		importReq.SourceType = organizationImportSourceAWSKMS
+		importReq.AwsKmsImport = &models.HandlerAWSKMSImportData{
+			SignCaCert:  data.AWSKMSImport.SignCACert.ValueStringPointer(),
+			SignCaKeyID: data.AWSKMSImport.SignCAKeyID.ValueStringPointer(),
+			TLSCaCert:   data.AWSKMSImport.TLSCACert.ValueStringPointer(),
+			TLSCaKeyID:  data.AWSKMSImport.TLSCAKeyID.ValueStringPointer(),
+		}
+	}
+
+	// DoRequest rather than c.API: see importOrganizationRequest
+	body, err := r.client.DoRequest(ctx, "POST", "/organizations/import", importReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import organization, got error: %s", err))
+		return
+	}
+
+	var org models.HandlerOrganizationResponse
+	if err := json.Unmarshal(body, &org); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse organization response: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(org.ID, 10))
+	data.SourceType = types.StringValue(importReq.SourceType)
+	setOrganizationImportAttributes(&data, &org)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricOrganizationImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FabricOrganizationImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.Organizations.GetFabricOrganization(organizations.NewGetFabricOrganizationParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization, got error: %s", err))
+		return
+	}
+	org := got.Payload
+
+	// Record the certificates Chainlaunch holds in place of the imported ones
+	// when they differ, so that the next plan replaces the organization
+	if data.RawImport != nil {
+		data.RawImport.SignCACert = certificateDrift(data.RawImport.SignCACert, org.SignCertificate)
+		data.RawImport.TLSCACert = certificateDrift(data.RawImport.TLSCACert, org.TLSCertificate)
+	}
+	if data.AWSKMSImport != nil {
+		data.AWSKMSImport.SignCACert = certificateDrift(data.AWSKMSImport.SignCACert, org.SignCertificate)
+		data.AWSKMSImport.TLSCACert = certificateDrift(data.AWSKMSImport.TLSCACert, org.TLSCertificate)
+	}
+
+	setOrganizationImportAttributes(&data, org)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricOrganizationImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FabricOrganizationImportResourceModel
+
+	// Only the description can change without replacing the organization
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	updateReq := &models.HandlerUpdateOrganizationRequest{
+		Description: data.Description.ValueString(),
+	}
+
+	updated, err := r.client.API.Organizations.UpdateFabricOrganization(organizations.NewUpdateFabricOrganizationParamsWithContext(ctx).WithID(id).WithRequest(updateReq), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization, got error: %s", err))
+		return
+	}
+
+	setOrganizationImportAttributes(&data, updated.Payload)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FabricOrganizationImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FabricOrganizationImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Organizations.DeleteFabricOrganization(organizations.NewDeleteFabricOrganizationParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete organization, got error: %s", err))
+		return
+	}
+}
+
+// setOrganizationImportAttributes copies the computed attributes of an organization into data.
+func setOrganizationImportAttributes(data *FabricOrganizationImportResourceModel, org *models.HandlerOrganizationResponse) {
+	data.MSPID = types.StringValue(org.MspID)
+	if org.Description != "" {
+		data.Description = types.StringValue(org.Description)
+	}
+	data.SignCertificate = types.StringValue(org.SignCertificate)
+	data.TLSCertificate = types.StringValue(org.TLSCertificate)
+	data.SignPublicKey = types.StringValue(org.SignPublicKey)
+	data.TLSPublicKey = types.StringValue(org.TLSPublicKey)
+	data.CreatedAt = stringValueOrNull(org.CreatedAt)
+	data.UpdatedAt = stringValueOrNull(org.UpdatedAt)
+}
+
+// certificateDrift returns the imported certificate while the server still
+// holds the same one, and the server's certificate once they differ. An empty
+// server value is not treated as drift.
+func certificateDrift(imported types.String, server string) types.String {
+	if server == "" || samePEMCertificates(imported.ValueString(), server) {
+		return imported
+	}
+	return types.StringValue(server)
+}
+
+// samePEMCertificates reports whether two PEM bundles hold the same
+// certificates, ignoring whitespace, line endings and text around the blocks.
+func samePEMCertificates(a, b string) bool {
+	derA, derB := pemCertificateBytes(a), pemCertificateBytes(b)
+	if derA == nil || derB == nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	return bytes.Equal(derA, derB)
+}
+
+// pemCertificateBytes concatenates the DER bytes of the PEM blocks in s, or
+// returns nil when s holds no PEM block. Lines are trimmed first, since
+// certificates pasted into indented heredocs keep their indentation.
+func pemCertificateBytes(s string) []byte {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	var der []byte
+	rest := []byte(strings.Join(lines, "\n"))
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return der
+		}
+		der = append(der, block.Bytes...)
+	}
+}
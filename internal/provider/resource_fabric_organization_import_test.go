@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testCertificatePEM = `-----BEGIN CERTIFICATE-----
+MIIBhTCCASugAwIBAgIQIRi6zePL6mKjOipn+dNuaTAKBggqhkjOPQQDAjASMRAw
+DgYDVQQKEwdBY21lIENvMB4XDTE3MTAyMDE5NDMwNloXDTE4MTAyMDE5NDMwNlow
+EjEQMA4GA1UEChMHQWNtZSBDbzBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABD0d
+7VNhbWvZLWPuj/RtHFjvtJBEwOkhbN/BnnE8rnZR8+sbwnc/KhCk3FhnpHZnQz7B
+5aETbbIgmuvewdjvSBSjYzBhMA4GA1UdDwEB/wQEAwICpDATBgNVHSUEDDAKBggr
+BgEFBQcDATAPBgNVHRMBAf8EBTADAQH/MCkGA1UdEQQiMCCCDmxvY2FsaG9zdDo1
+NDUzgg4xMjcuMC4wLjE6NTQ1MzAKBggqhkjOPQQDAgNIADBFAiEA2zpJEPQyz6/l
+Wf86aX6PepsntZv2GYlA5UpabfT2EZICICpJ5h/iI+i341gBmLiAFQOyTDT+/wQc
+6MF9+Yw1Yy0t
+-----END CERTIFICATE-----
+`
+
+func TestSamePEMCertificates(t *testing.T) {
+	reformatted := "\r\n  " + testCertificatePEM[:64] + "\r\n" + testCertificatePEM[64:]
+	if !samePEMCertificates(testCertificatePEM, reformatted) {
+		t.Error("expected whitespace and line endings to be ignored")
+	}
+
+	other := testCertificatePEM[:100] + "A" + testCertificatePEM[101:]
+	if samePEMCertificates(testCertificatePEM, other) {
+		t.Error("expected different certificates to differ")
+	}
+}
+
+func TestCertificateDrift(t *testing.T) {
+	imported := types.StringValue(testCertificatePEM)
+
+	if got := certificateDrift(imported, testCertificatePEM+"\n"); !got.Equal(imported) {
+		t.Errorf("expected the imported certificate to be kept, got %s", got)
+	}
+	if got := certificateDrift(imported, ""); !got.Equal(imported) {
+		t.Errorf("expected an empty server certificate to be ignored, got %s", got)
+	}
+	if got := certificateDrift(imported, "-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n"); got.Equal(imported) {
+		t.Error("expected a different server certificate to replace the imported one")
+	}
+}
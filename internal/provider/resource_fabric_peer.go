@@ -5,9 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,10 +16,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &FabricPeerResource{}
 var _ resource.ResourceWithImportState = &FabricPeerResource{}
+var _ resource.ResourceWithValidateConfig = &FabricPeerResource{}
 
 func NewFabricPeerResource() resource.Resource {
 	return &FabricPeerResource{}
@@ -29,26 +34,29 @@ type FabricPeerResource struct {
 }
 
 type FabricPeerResourceModel struct {
-	ID                      types.String `tfsdk:"id"`
-	Name                    types.String `tfsdk:"name"`
-	OrganizationID          types.Int64  `tfsdk:"organization_id"`
-	MspID                   types.String `tfsdk:"msp_id"`
-	Mode                    types.String `tfsdk:"mode"`
-	Version                 types.String `tfsdk:"version"`
-	ListenAddress           types.String `tfsdk:"listen_address"`
-	ChaincodeAddress        types.String `tfsdk:"chaincode_address"`
-	EventsAddress           types.String `tfsdk:"events_address"`
-	OperationsListenAddress types.String `tfsdk:"operations_listen_address"`
-	ExternalEndpoint        types.String `tfsdk:"external_endpoint"`
-	AddressOverrides        types.List   `tfsdk:"address_overrides"`
-	DomainNames             types.List   `tfsdk:"domain_names"`
-	CertificateExpiration   types.Int64  `tfsdk:"certificate_expiration"`
-	AutoRenewalEnabled      types.Bool   `tfsdk:"auto_renewal_enabled"`
-	AutoRenewalDays         types.Int64  `tfsdk:"auto_renewal_days"`
-	Environment             types.Map    `tfsdk:"environment"`
-	Status                  types.String `tfsdk:"status"`
-	CreatedAt               types.String `tfsdk:"created_at"`
-	UpdatedAt               types.String `tfsdk:"updated_at"`
+	ID                      types.String   `tfsdk:"id"`
+	Name                    types.String   `tfsdk:"name"`
+	OrganizationID          types.Int64    `tfsdk:"organization_id"`
+	MspID                   types.String   `tfsdk:"msp_id"`
+	Mode                    types.String   `tfsdk:"mode"`
+	Version                 types.String   `tfsdk:"version"`
+	ListenAddress           types.String   `tfsdk:"listen_address"`
+	ChaincodeAddress        types.String   `tfsdk:"chaincode_address"`
+	EventsAddress           types.String   `tfsdk:"events_address"`
+	OperationsListenAddress types.String   `tfsdk:"operations_listen_address"`
+	ExternalEndpoint        types.String   `tfsdk:"external_endpoint"`
+	AddressOverrides        types.List     `tfsdk:"address_overrides"`
+	DomainNames             types.List     `tfsdk:"domain_names"`
+	CertificateExpiration   types.Int64    `tfsdk:"certificate_expiration"`
+	AutoRenewalEnabled      types.Bool     `tfsdk:"auto_renewal_enabled"`
+	AutoRenewalDays         types.Int64    `tfsdk:"auto_renewal_days"`
+	Environment             types.Map      `tfsdk:"environment"`
+	DesiredState            types.String   `tfsdk:"desired_state"`
+	RestartTriggers         types.Map      `tfsdk:"restart_triggers"`
+	Status                  types.String   `tfsdk:"status"`
+	CreatedAt               types.String   `tfsdk:"created_at"`
+	UpdatedAt               types.String   `tfsdk:"updated_at"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
 }
 
 type AddressOverrideModel struct {
@@ -96,14 +104,11 @@ func (r *FabricPeerResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"mode": schema.StringAttribute{
 				Required:    true,
-				Description: "The deployment mode: 'docker' or 'service'.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "The deployment mode: 'docker' or 'service'. Changing it updates the node in place.",
 			},
 			"version": schema.StringAttribute{
 				Required:    true,
-				Description: "Fabric version to use (e.g., 2.2.0, 2.5.0, 2.5.9).",
+				Description: "Fabric version to use (e.g., 2.2.0, 2.5.0, 2.5.9). Changing it upgrades the node in place and restarts it.",
 			},
 			"listen_address": schema.StringAttribute{
 				Required:    true,
@@ -167,6 +172,8 @@ func (r *FabricPeerResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Optional:    true,
 				Description: "Environment variables for the peer container.",
 			},
+			"desired_state":    desiredStateAttribute(),
+			"restart_triggers": restartTriggersAttribute(),
 			"status": schema.StringAttribute{
 				Computed:    true,
 				Description: "The current status of the peer node.",
@@ -180,6 +187,22 @@ func (r *FabricPeerResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Description: "The timestamp when the peer was last updated.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *FabricPeerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var desiredState types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("desired_state"), &desiredState)...)
+	if err := validateDesiredState(desiredState); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("desired_state"), "Invalid Desired State", err.Error())
 	}
 }
 
@@ -208,108 +231,61 @@ func (r *FabricPeerResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Build the FabricPeerConfig
-	peerConfig := map[string]interface{}{
-		"name":           data.Name.ValueString(),
-		"organizationId": data.OrganizationID.ValueInt64(),
-		"mspId":          data.MspID.ValueString(),
-		"type":           "fabric-peer",
-	}
-
-	// Add optional fields
-	if !data.Mode.IsNull() {
-		peerConfig["mode"] = data.Mode.ValueString()
-	}
-	if !data.Version.IsNull() {
-		peerConfig["version"] = data.Version.ValueString()
-	}
-	if !data.ListenAddress.IsNull() {
-		peerConfig["listenAddress"] = data.ListenAddress.ValueString()
-	}
-	if !data.ChaincodeAddress.IsNull() {
-		peerConfig["chaincodeAddress"] = data.ChaincodeAddress.ValueString()
-	}
-	if !data.EventsAddress.IsNull() {
-		peerConfig["eventsAddress"] = data.EventsAddress.ValueString()
-	}
-	if !data.OperationsListenAddress.IsNull() {
-		peerConfig["operationsListenAddress"] = data.OperationsListenAddress.ValueString()
-	}
-	if !data.ExternalEndpoint.IsNull() {
-		peerConfig["externalEndpoint"] = data.ExternalEndpoint.ValueString()
-	}
-	if !data.CertificateExpiration.IsNull() {
-		peerConfig["certificateExpiration"] = data.CertificateExpiration.ValueInt64()
-	}
-	if !data.AutoRenewalEnabled.IsNull() {
-		peerConfig["autoRenewalEnabled"] = data.AutoRenewalEnabled.ValueBool()
-	}
-	if !data.AutoRenewalDays.IsNull() {
-		peerConfig["autoRenewalDays"] = data.AutoRenewalDays.ValueInt64()
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Handle address overrides list
-	if !data.AddressOverrides.IsNull() {
-		var addressOverrides []AddressOverrideModel
-		diags := data.AddressOverrides.ElementsAs(ctx, &addressOverrides, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-		// Convert to API format
-		overrides := make([]map[string]interface{}, len(addressOverrides))
-		for i, override := range addressOverrides {
-			overrides[i] = map[string]interface{}{
-				"from": override.From.ValueString(),
-				"to":   override.To.ValueString(),
-			}
-			if !override.TLSCACert.IsNull() {
-				overrides[i]["tlsCACert"] = override.TLSCACert.ValueString()
-			}
-		}
-		peerConfig["addressOverrides"] = overrides
-	}
-
-	// Handle domain names list
+	// Build the FabricPeerConfig
+	peerConfig := &models.TypesFabricPeerConfig{
+		Name:                    data.Name.ValueStringPointer(),
+		OrganizationID:          data.OrganizationID.ValueInt64Pointer(),
+		MspID:                   data.MspID.ValueStringPointer(),
+		Type:                    "fabric-peer",
+		Mode:                    data.Mode.ValueString(),
+		Version:                 data.Version.ValueString(),
+		ListenAddress:           data.ListenAddress.ValueString(),
+		ChaincodeAddress:        data.ChaincodeAddress.ValueString(),
+		EventsAddress:           data.EventsAddress.ValueString(),
+		OperationsListenAddress: data.OperationsListenAddress.ValueString(),
+		ExternalEndpoint:        data.ExternalEndpoint.ValueString(),
+		CertificateExpiration:   data.CertificateExpiration.ValueInt64(),
+		AutoRenewalEnabled:      data.AutoRenewalEnabled.ValueBool(),
+		AutoRenewalDays:         data.AutoRenewalDays.ValueInt64(),
+	}
+
+	peerConfig.AddressOverrides, diags = expandAddressOverrides(ctx, data.AddressOverrides)
+	resp.Diagnostics.Append(diags...)
 	if !data.DomainNames.IsNull() {
-		var domainNames []string
-		diags := data.DomainNames.ElementsAs(ctx, &domainNames, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		peerConfig["domainNames"] = domainNames
+		resp.Diagnostics.Append(data.DomainNames.ElementsAs(ctx, &peerConfig.DomainNames, false)...)
 	}
-
-	// Handle environment variables map
 	if !data.Environment.IsNull() {
-		var envVars map[string]string
-		diags := data.Environment.ElementsAs(ctx, &envVars, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		peerConfig["env"] = envVars
+		resp.Diagnostics.Append(data.Environment.ElementsAs(ctx, &peerConfig.Env, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Build the CreateNodeRequest
-	createReq := map[string]interface{}{
-		"name":               data.Name.ValueString(),
-		"blockchainPlatform": "FABRIC",
-		"fabricPeer":         peerConfig,
+	createReq := createNodeRequest{
+		Name:               data.Name.ValueString(),
+		BlockchainPlatform: models.TypesBlockchainPlatformFABRIC,
+		FabricPeer:         peerConfig,
 	}
 
-	body, err := r.client.DoRequest("POST", "/nodes", createReq)
+	body, err := r.client.DoRequest(ctx, "POST", "/nodes", createReq)
 
 	// Parse response - even if there's an error, the response might contain node data
-	var nodeResp NodeResponse
+	var nodeResp models.HTTPNodeResponse
 	var errorResponse struct {
 		Message string `json:"message"`
 		Data    struct {
-			Node   *NodeResponse `json:"node"`
-			NodeID int64         `json:"node_id"`
-			Stage  string        `json:"stage"`
+			Node   *models.HTTPNodeResponse `json:"node"`
+			NodeID int64                    `json:"node_id"`
+			Stage  string                   `json:"stage"`
 		} `json:"data"`
 	}
 
@@ -382,6 +358,16 @@ func (r *FabricPeerResource) Create(ctx context.Context, req resource.CreateRequ
 		data.Status = types.StringValue("RUNNING")
 	}
 
+	// Park the node when it should not run
+	if data.DesiredState.ValueString() == desiredStateStopped {
+		stopped, err := reconcileNodeState(ctx, r.client, nodeResp.ID, desiredStateStopped, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to stop fabric peer, got error: %s", err))
+		} else {
+			data.Status = types.StringValue(stopped.Status)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -393,7 +379,13 @@ func (r *FabricPeerResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		// If resource not found (404), remove from state
 		if IsNotFoundError(err) {
@@ -403,18 +395,14 @@ func (r *FabricPeerResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read fabric peer, got error: %s", err))
 		return
 	}
-
-	var nodeResp NodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peer response, got error: %s", err))
-		return
-	}
+	nodeResp := got.Payload
 
 	// Update state from API response
 	// Note: Some fields might not be returned by API, preserve them from state
 	if nodeResp.Status != "" {
 		data.Status = types.StringValue(nodeResp.Status)
 	}
+	data.DesiredState = observedDesiredState(nodeResp.Status, data.DesiredState)
 	if nodeResp.CreatedAt != "" {
 		data.CreatedAt = types.StringValue(nodeResp.CreatedAt)
 	}
@@ -422,38 +410,31 @@ func (r *FabricPeerResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
 	}
 
-	// Try to parse address_overrides from the API response if available
-	if nodeResp.FabricPeer != nil {
-		if overrides, ok := nodeResp.FabricPeer["addressOverrides"].([]interface{}); ok && len(overrides) > 0 {
-			// Parse address overrides from API response
-			addressOverrides := make([]AddressOverrideModel, len(overrides))
-			for i, override := range overrides {
-				if overrideMap, ok := override.(map[string]interface{}); ok {
-					if from, ok := overrideMap["from"].(string); ok {
-						addressOverrides[i].From = types.StringValue(from)
-					}
-					if to, ok := overrideMap["to"].(string); ok {
-						addressOverrides[i].To = types.StringValue(to)
-					}
-					if tlsCACert, ok := overrideMap["tlsCACert"].(string); ok && tlsCACert != "" {
-						addressOverrides[i].TLSCACert = types.StringValue(tlsCACert)
-					} else {
-						addressOverrides[i].TLSCACert = types.StringNull()
-					}
-				}
-			}
-			// Convert to types.List
-			addressOverridesList, diags := types.ListValueFrom(ctx, types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"from":        types.StringType,
-					"to":          types.StringType,
-					"tls_ca_cert": types.StringType,
-				},
-			}, addressOverrides)
+	// Refresh the configuration that can be updated in place, so that changes
+	// made outside Terraform show up as drift
+	if peer := nodeResp.FabricPeer; peer != nil {
+		refreshString(&data.MspID, peer.MspID)
+		if peer.OrganizationID != 0 {
+			data.OrganizationID = types.Int64Value(peer.OrganizationID)
+		}
+		refreshString(&data.Mode, peer.Mode)
+		refreshString(&data.Version, peer.Version)
+		refreshString(&data.ListenAddress, peer.ListenAddress)
+		refreshString(&data.ChaincodeAddress, peer.ChaincodeAddress)
+		refreshString(&data.EventsAddress, peer.EventsAddress)
+		refreshString(&data.OperationsListenAddress, peer.OperationsAddress)
+		refreshString(&data.ExternalEndpoint, peer.ExternalEndpoint)
+
+		if len(peer.AddressOverrides) > 0 || !data.AddressOverrides.IsNull() {
+			addressOverrides, diags := flattenAddressOverrides(ctx, peer.AddressOverrides)
 			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.AddressOverrides = addressOverridesList
-			}
+			data.AddressOverrides = addressOverrides
+		}
+		domainNames, diags := refreshStringList(ctx, data.DomainNames, peer.DomainNames)
+		resp.Diagnostics.Append(diags...)
+		data.DomainNames = domainNames
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -476,119 +457,105 @@ func (r *FabricPeerResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	// Build the FabricPeerConfig for update
-	peerConfig := map[string]interface{}{
-		"name":           data.Name.ValueString(),
-		"organizationId": data.OrganizationID.ValueInt64(),
-		"mspId":          data.MspID.ValueString(),
-		"type":           "fabric-peer",
-	}
-
-	// Add optional fields
-	if !data.Mode.IsNull() {
-		peerConfig["mode"] = data.Mode.ValueString()
-	}
-	if !data.Version.IsNull() {
-		peerConfig["version"] = data.Version.ValueString()
-	}
-	if !data.ListenAddress.IsNull() {
-		peerConfig["listenAddress"] = data.ListenAddress.ValueString()
-	}
-	if !data.ChaincodeAddress.IsNull() {
-		peerConfig["chaincodeAddress"] = data.ChaincodeAddress.ValueString()
-	}
-	if !data.EventsAddress.IsNull() {
-		peerConfig["eventsAddress"] = data.EventsAddress.ValueString()
-	}
-	if !data.OperationsListenAddress.IsNull() {
-		peerConfig["operationsListenAddress"] = data.OperationsListenAddress.ValueString()
-	}
-	if !data.ExternalEndpoint.IsNull() {
-		peerConfig["externalEndpoint"] = data.ExternalEndpoint.ValueString()
-	}
-	if !data.CertificateExpiration.IsNull() {
-		peerConfig["certificateExpiration"] = data.CertificateExpiration.ValueInt64()
-	}
-	if !data.AutoRenewalEnabled.IsNull() {
-		peerConfig["autoRenewalEnabled"] = data.AutoRenewalEnabled.ValueBool()
-	}
-	if !data.AutoRenewalDays.IsNull() {
-		peerConfig["autoRenewalDays"] = data.AutoRenewalDays.ValueInt64()
+	// Only send the node configuration when it changed; desired_state and
+	// restart_triggers alone are applied below
+	configChanged, err := nodeConfigChanged(req)
+	if err != nil {
+		resp.Diagnostics.AddError("Plan Error", fmt.Sprintf("Unable to compare the planned fabric peer configuration, got error: %s", err))
+		return
 	}
+	data.Status = state.Status
+	data.UpdatedAt = state.UpdatedAt
+
+	if configChanged {
+		// Build the FabricPeerConfig for update
+		peerConfig := &updateFabricPeerRequest{
+			HTTPUpdateFabricPeerRequest: models.HTTPUpdateFabricPeerRequest{
+				Mode:                    data.Mode.ValueString(),
+				Version:                 data.Version.ValueString(),
+				ListenAddress:           data.ListenAddress.ValueString(),
+				ChaincodeAddress:        data.ChaincodeAddress.ValueString(),
+				EventsAddress:           data.EventsAddress.ValueString(),
+				OperationsListenAddress: data.OperationsListenAddress.ValueString(),
+				ExternalEndpoint:        data.ExternalEndpoint.ValueString(),
+			},
+		}
 
-	// Handle address overrides list
-	if !data.AddressOverrides.IsNull() {
-		var addressOverrides []AddressOverrideModel
-		diags := data.AddressOverrides.ElementsAs(ctx, &addressOverrides, false)
+		// Empty collections rather than null, so that removing address_overrides,
+		// domain_names or environment clears them on the node
+		peerConfig.AddressOverrides, diags = expandAddressOverrides(ctx, data.AddressOverrides)
 		resp.Diagnostics.Append(diags...)
+		if peerConfig.AddressOverrides == nil {
+			peerConfig.AddressOverrides = []*models.TypesAddressOverride{}
+		}
+		peerConfig.DomainNames = []string{}
+		if !data.DomainNames.IsNull() {
+			resp.Diagnostics.Append(data.DomainNames.ElementsAs(ctx, &peerConfig.DomainNames, false)...)
+		}
+		peerConfig.Env = map[string]string{}
+		if !data.Environment.IsNull() {
+			resp.Diagnostics.Append(data.Environment.ElementsAs(ctx, &peerConfig.Env, false)...)
+		}
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		// Convert to API format
-		overrides := make([]map[string]interface{}, len(addressOverrides))
-		for i, override := range addressOverrides {
-			overrides[i] = map[string]interface{}{
-				"from": override.From.ValueString(),
-				"to":   override.To.ValueString(),
-			}
-			if !override.TLSCACert.IsNull() {
-				overrides[i]["tlsCACert"] = override.TLSCACert.ValueString()
-			}
+		// Build the update request
+		updateReq := updateNodeRequest{
+			Name:               data.Name.ValueString(),
+			BlockchainPlatform: models.TypesBlockchainPlatformFABRIC,
+			FabricPeer:         peerConfig,
 		}
-		peerConfig["addressOverrides"] = overrides
-	}
 
-	// Handle domain names list
-	if !data.DomainNames.IsNull() {
-		var domainNames []string
-		diags := data.DomainNames.ElementsAs(ctx, &domainNames, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		body, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update fabric peer, got error: %s", err))
 			return
 		}
-		peerConfig["domainNames"] = domainNames
-	}
 
-	// Handle environment variables map
-	if !data.Environment.IsNull() {
-		var envVars map[string]string
-		diags := data.Environment.ElementsAs(ctx, &envVars, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+		var nodeResp models.HTTPNodeResponse
+		if err := json.Unmarshal(body, &nodeResp); err != nil {
+			resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peer response, got error: %s", err))
 			return
 		}
-		peerConfig["env"] = envVars
-	}
 
-	// Build the update request
-	updateReq := map[string]interface{}{
-		"name":               data.Name.ValueString(),
-		"blockchainPlatform": "FABRIC",
-		"fabricPeer":         peerConfig,
+		// Update state from response
+		if nodeResp.Status != "" {
+			data.Status = types.StringValue(nodeResp.Status)
+		}
+		if nodeResp.UpdatedAt != "" {
+			data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
+		}
 	}
 
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update fabric peer, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var nodeResp NodeResponse
-	if err := json.Unmarshal(body, &nodeResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse peer response, got error: %s", err))
+	// A running node is restarted to pick up a new configuration, such as a
+	// version upgrade, and the update only completes once it is RUNNING again
+	node, err := reconcileNodeState(ctx, r.client, id, data.DesiredState.ValueString(), configChanged || restartRequested(state.RestartTriggers, data.RestartTriggers))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply desired_state to fabric peer, got error: %s", err))
 		return
 	}
-
-	// Update state from response
-	if nodeResp.Status != "" {
-		data.Status = types.StringValue(nodeResp.Status)
-	}
-	if nodeResp.UpdatedAt != "" {
-		data.UpdatedAt = types.StringValue(nodeResp.UpdatedAt)
+	data.Status = types.StringValue(node.Status)
+	if node.UpdatedAt != "" {
+		data.UpdatedAt = types.StringValue(node.UpdatedAt)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -602,7 +569,22 @@ func (r *FabricPeerResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Nodes.DeleteNode(nodes.NewDeleteNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete fabric peer, got error: %s", err))
 		return
@@ -615,41 +597,18 @@ func (r *FabricPeerResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-// NodeResponse represents the API response when creating/reading a node
-type NodeResponse struct {
-	ID         int64                  `json:"id"`
-	Name       string                 `json:"name"`
-	Platform   string                 `json:"blockchainPlatform,omitempty"`
-	Status     string                 `json:"status,omitempty"`
-	CreatedAt  string                 `json:"createdAt,omitempty"`
-	UpdatedAt  string                 `json:"updatedAt,omitempty"`
-	Config     map[string]interface{} `json:"config,omitempty"`
-	FabricPeer map[string]interface{} `json:"fabricPeer,omitempty"`
-}
-
-// waitForPeerRunning polls the node status until it reaches RUNNING state or timeout
+// waitForPeerRunning polls the node status until it reaches RUNNING state or ctx expires
 func (r *FabricPeerResource) waitForPeerRunning(ctx context.Context, peerID int64) error {
-	maxAttempts := 60 // 60 attempts
-	delaySeconds := 2 // 2 seconds between attempts (total 120 seconds / 2 minutes)
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if context is cancelled
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("context cancelled while waiting for peer to reach RUNNING state")
-		default:
-		}
-
+	for {
 		// Get current node status
-		body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%d", peerID), nil)
+		got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(peerID), nil)
 		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("timed out waiting for peer to reach RUNNING state: %w", ctx.Err())
+			}
 			return fmt.Errorf("failed to check peer status: %w", err)
 		}
-
-		var nodeResp NodeResponse
-		if err := json.Unmarshal(body, &nodeResp); err != nil {
-			return fmt.Errorf("failed to parse peer status response: %w", err)
-		}
+		nodeResp := got.Payload
 
 		// Check if peer is running
 		if nodeResp.Status == "RUNNING" {
@@ -662,10 +621,78 @@ func (r *FabricPeerResource) waitForPeerRunning(ctx context.Context, peerID int6
 		}
 
 		// Not ready yet, wait and try again
-		if attempt < maxAttempts {
-			time.Sleep(time.Duration(delaySeconds) * time.Second)
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return fmt.Errorf("timed out waiting for peer to reach RUNNING state (last status %q): %w", nodeResp.Status, err)
 		}
 	}
+}
 
-	return fmt.Errorf("peer did not reach RUNNING state after %d attempts (%d seconds)", maxAttempts, maxAttempts*delaySeconds)
+// refreshString sets target to the value reported by the API, keeping the
+// current value when the API leaves the field empty.
+func refreshString(target *types.String, value string) {
+	if value != "" {
+		*target = types.StringValue(value)
+	}
+}
+
+// refreshStringList converts a list reported by the API, keeping a null list
+// null when the API reports no elements.
+func refreshStringList(ctx context.Context, current types.List, values []string) (types.List, diag.Diagnostics) {
+	if len(values) == 0 && current.IsNull() {
+		return current, nil
+	}
+	if values == nil {
+		values = []string{}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+// addressOverrideAttrTypes describes the elements of the address_overrides list.
+var addressOverrideAttrTypes = map[string]attr.Type{
+	"from":        types.StringType,
+	"to":          types.StringType,
+	"tls_ca_cert": types.StringType,
+}
+
+// expandAddressOverrides converts the address_overrides list into its API representation.
+func expandAddressOverrides(ctx context.Context, list types.List) ([]*models.TypesAddressOverride, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var addressOverrides []AddressOverrideModel
+	diags := list.ElementsAs(ctx, &addressOverrides, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	overrides := make([]*models.TypesAddressOverride, len(addressOverrides))
+	for i, override := range addressOverrides {
+		overrides[i] = &models.TypesAddressOverride{
+			From:      override.From.ValueString(),
+			To:        override.To.ValueString(),
+			TLSCACert: override.TLSCACert.ValueString(),
+		}
+	}
+	return overrides, diags
+}
+
+// flattenAddressOverrides converts address overrides returned by the API into the address_overrides list.
+func flattenAddressOverrides(ctx context.Context, overrides []*models.TypesAddressOverride) (types.List, diag.Diagnostics) {
+	addressOverrides := make([]AddressOverrideModel, 0, len(overrides))
+	for _, override := range overrides {
+		if override == nil {
+			continue
+		}
+		model := AddressOverrideModel{
+			From:      types.StringValue(override.From),
+			To:        types.StringValue(override.To),
+			TLSCACert: types.StringNull(),
+		}
+		if override.TLSCACert != "" {
+			model.TLSCACert = types.StringValue(override.TLSCACert)
+		}
+		addressOverrides = append(addressOverrides, model)
+	}
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: addressOverrideAttrTypes}, addressOverrides)
 }
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+func TestAddressOverridesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	overrides := []*models.TypesAddressOverride{
+		{From: "peer0.org1.example.com:7051", To: "peer0-org1:7051", TLSCACert: "-----BEGIN CERTIFICATE-----\n"},
+		{From: "orderer0.example.com:7050", To: "orderer0:7050"},
+	}
+
+	list, diags := flattenAddressOverrides(ctx, overrides)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	got, diags := expandAddressOverrides(ctx, list)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !reflect.DeepEqual(got, overrides) {
+		t.Errorf("address overrides changed on round trip: got %+v", got)
+	}
+}
+
+func TestRefreshStringList(t *testing.T) {
+	ctx := context.Background()
+
+	got, _ := refreshStringList(ctx, types.ListNull(types.StringType), nil)
+	if !got.IsNull() {
+		t.Errorf("expected an unset list to stay null, got %s", got)
+	}
+
+	current, _ := types.ListValueFrom(ctx, types.StringType, []string{"peer0.example.com"})
+	got, _ = refreshStringList(ctx, current, nil)
+	if got.IsNull() || len(got.Elements()) != 0 {
+		t.Errorf("expected names removed outside Terraform to show up as an empty list, got %s", got)
+	}
+
+	got, _ = refreshStringList(ctx, current, []string{"peer1.example.com"})
+	if want, _ := types.ListValueFrom(ctx, types.StringType, []string{"peer1.example.com"}); !got.Equal(want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestUpdateNodeRequestSendsEmptyEnv(t *testing.T) {
+	body, err := json.Marshal(updateNodeRequest{
+		FabricPeer: &updateFabricPeerRequest{
+			HTTPUpdateFabricPeerRequest: models.HTTPUpdateFabricPeerRequest{Version: "2.5.12"},
+			Env:                         map[string]string{},
+		},
+		FabricOrderer: &updateFabricOrdererRequest{
+			Env: map[string]string{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to encode request: %v", err)
+	}
+
+	for _, want := range []string{`"fabricPeer":{`, `"version":"2.5.12"`, `"fabricOrderer":{`} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("expected %s in %s", want, body)
+		}
+	}
+	if n := strings.Count(string(body), `"env":{}`); n != 2 {
+		t.Errorf("expected an empty env for both nodes so that removed variables are cleared, got %s", body)
+	}
+}
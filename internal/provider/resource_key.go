@@ -2,11 +2,8 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
-	"strings"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/keys"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -149,92 +149,35 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	// Build the request payload
-	payload := map[string]interface{}{
-		"name":       data.Name.ValueString(),
-		"algorithm":  data.Algorithm.ValueString(),
-		"providerId": data.ProviderID.ValueInt64(),
-	}
-
-	// Add optional fields
-	if !data.Curve.IsNull() {
-		payload["curve"] = data.Curve.ValueString()
-	}
-
-	if !data.KeySize.IsNull() {
-		payload["keySize"] = data.KeySize.ValueInt64()
+	name := data.Name.ValueString()
+	algorithm := data.Algorithm.ValueString()
+	createReq := &models.HandlerCreateKeyHandlerRequest{
+		Name:        &name,
+		Algorithm:   &algorithm,
+		ProviderID:  data.ProviderID.ValueInt64(),
+		Curve:       data.Curve.ValueString(),
+		KeySize:     data.KeySize.ValueInt64(),
+		Description: data.Description.ValueString(),
 	}
 
 	// Default is_ca to false if not specified
-	if !data.IsCA.IsNull() {
-		if data.IsCA.ValueBool() {
-			payload["isCA"] = 1
-		} else {
-			payload["isCA"] = 0
-		}
-	} else {
-		payload["isCA"] = 0 // Default to false
+	if data.IsCA.IsNull() {
 		data.IsCA = types.BoolValue(false)
 	}
-
-	if !data.Description.IsNull() {
-		payload["description"] = data.Description.ValueString()
-	}
-
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal key request: %s", err))
-		return
-	}
-
-	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/keys", r.client.BaseURL), strings.NewReader(string(jsonData)))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request: %s", err))
-		return
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	if r.client.APIKey != "" {
-		httpReq.Header.Set("X-API-Key", r.client.APIKey)
-	} else {
-		httpReq.SetBasicAuth(r.client.Username, r.client.Password)
+	if data.IsCA.ValueBool() {
+		createReq.IsCA = 1
 	}
 
-	httpResp, err := r.client.HTTPClient.Do(httpReq)
+	params := keys.NewCreateKeyParamsWithContext(ctx).WithRequest(createReq)
+	created, err := r.client.API.Keys.CreateKey(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create key: %s", err))
 		return
 	}
-	defer func() { _ = httpResp.Body.Close() }()
 
-	body, _ := io.ReadAll(httpResp.Body)
-
-	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusCreated {
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to create key, status code: %d, body: %s", httpResp.StatusCode, string(body)),
-		)
-		return
-	}
-
-	var keyResp struct {
-		ID        int64  `json:"id"`
-		PublicKey string `json:"publicKey"`
-		CreatedAt string `json:"createdAt"`
-	}
-
-	if err := json.Unmarshal(body, &keyResp); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse key response: %s", err))
-		return
-	}
-
-	data.ID = types.StringValue(fmt.Sprintf("%d", keyResp.ID))
-	data.PublicKey = types.StringValue(keyResp.PublicKey)
-	data.CreatedAt = types.StringValue(keyResp.CreatedAt)
-
-	// Ensure is_ca is set to a known value (false if not specified)
-	if data.IsCA.IsNull() {
-		data.IsCA = types.BoolValue(false)
-	}
+	data.ID = types.StringValue(strconv.FormatInt(created.Payload.ID, 10))
+	data.PublicKey = types.StringValue(created.Payload.PublicKey)
+	data.CreatedAt = types.StringValue(created.Payload.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -248,78 +191,53 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		return
 	}
 
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/keys/%s", r.client.BaseURL, data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	if r.client.APIKey != "" {
-		httpReq.Header.Set("X-API-Key", r.client.APIKey)
-	} else {
-		httpReq.SetBasicAuth(r.client.Username, r.client.Password)
-	}
-
-	httpResp, err := r.client.HTTPClient.Do(httpReq)
+	key, err := r.client.API.Keys.GetKeyByID(keys.NewGetKeyByIDParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key: %s", err))
 		return
 	}
-	defer func() { _ = httpResp.Body.Close() }()
 
-	if httpResp.StatusCode == http.StatusNotFound {
-		resp.State.RemoveResource(ctx)
-		return
+	// Preserve name from state, which is only null right after an import
+	if data.Name.IsNull() && key.Payload.Name != "" {
+		data.Name = types.StringValue(key.Payload.Name)
 	}
 
-	body, _ := io.ReadAll(httpResp.Body)
+	data.Algorithm = types.StringValue(string(key.Payload.Algorithm))
 
-	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to read key, status code: %d, body: %s", httpResp.StatusCode, string(body)),
-		)
-		return
+	if key.Payload.Curve != "" {
+		data.Curve = types.StringValue(string(key.Payload.Curve))
 	}
 
-	var key struct {
-		ID          int64  `json:"id"`
-		Algorithm   string `json:"algorithm"`
-		Curve       string `json:"curve"`
-		KeySize     int64  `json:"keySize"`
-		IsCA        bool   `json:"isCA"`
-		Description string `json:"description"`
-		PublicKey   string `json:"publicKey"`
-		CreatedAt   string `json:"createdAt"`
+	if key.Payload.KeySize > 0 {
+		data.KeySize = types.Int64Value(key.Payload.KeySize)
 	}
 
-	if err := json.Unmarshal(body, &key); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse key response: %s", err))
-		return
-	}
-
-	// Preserve name from state (API may not return it)
-	// data.Name is already set from req.State.Get(ctx, &data)
-
-	data.Algorithm = types.StringValue(key.Algorithm)
-
-	if key.Curve != "" {
-		data.Curve = types.StringValue(key.Curve)
+	if key.Payload.Provider != nil && key.Payload.Provider.ID > 0 {
+		data.ProviderID = types.Int64Value(key.Payload.Provider.ID)
 	}
 
-	if key.KeySize > 0 {
-		data.KeySize = types.Int64Value(key.KeySize)
+	// The API does not return is_ca; keep the value from state, which is null
+	// right after an import
+	if data.IsCA.IsNull() {
+		data.IsCA = types.BoolValue(false)
 	}
 
-	// Set is_ca from API, defaulting to false if not present
-	data.IsCA = types.BoolValue(key.IsCA)
-
-	if key.Description != "" {
-		data.Description = types.StringValue(key.Description)
+	if key.Payload.Description != "" {
+		data.Description = types.StringValue(key.Payload.Description)
 	}
 
-	data.PublicKey = types.StringValue(key.PublicKey)
-	data.CreatedAt = types.StringValue(key.CreatedAt)
+	data.PublicKey = types.StringValue(key.Payload.PublicKey)
+	data.CreatedAt = types.StringValue(key.Payload.CreatedAt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -343,59 +261,18 @@ func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	// Build the update payload (only mutable fields)
-	payload := map[string]interface{}{}
-
-	if !data.Description.IsNull() {
-		payload["description"] = data.Description.ValueString()
-	}
-
-	// Default is_ca to false if not specified
-	if !data.IsCA.IsNull() {
-		if data.IsCA.ValueBool() {
-			payload["isCA"] = 1
-		} else {
-			payload["isCA"] = 0
-		}
-	} else {
-		payload["isCA"] = 0 // Default to false
-	}
-
-	jsonData, err := json.Marshal(payload)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to marshal key update request: %s", err))
-		return
-	}
-
-	httpReq, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/v1/keys/%s", r.client.BaseURL, data.ID.ValueString()), strings.NewReader(string(jsonData)))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request: %s", err))
-		return
+	// Only the mutable fields are sent
+	updateReq := updateKeyRequest{
+		Description: data.Description.ValueStringPointer(),
 	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	if r.client.APIKey != "" {
-		httpReq.Header.Set("X-API-Key", r.client.APIKey)
-	} else {
-		httpReq.SetBasicAuth(r.client.Username, r.client.Password)
+	if data.IsCA.ValueBool() {
+		updateReq.IsCA = 1
 	}
 
-	httpResp, err := r.client.HTTPClient.Do(httpReq)
-	if err != nil {
+	if _, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/keys/%s", data.ID.ValueString()), updateReq); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update key: %s", err))
 		return
 	}
-	defer func() { _ = httpResp.Body.Close() }()
-
-	body, _ := io.ReadAll(httpResp.Body)
-
-	if httpResp.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to update key, status code: %d, body: %s", httpResp.StatusCode, string(body)),
-		)
-		return
-	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -409,33 +286,16 @@ func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	httpReq, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/keys/%s", r.client.BaseURL, data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create request: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	if r.client.APIKey != "" {
-		httpReq.Header.Set("X-API-Key", r.client.APIKey)
-	} else {
-		httpReq.SetBasicAuth(r.client.Username, r.client.Password)
-	}
-
-	httpResp, err := r.client.HTTPClient.Do(httpReq)
-	if err != nil {
+	if _, err := r.client.API.Keys.DeleteKey(keys.NewDeleteKeyParamsWithContext(ctx).WithID(id), nil); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete key: %s", err))
 		return
 	}
-	defer func() { _ = httpResp.Body.Close() }()
-
-	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
-		body, _ := io.ReadAll(httpResp.Body)
-		resp.Diagnostics.AddError(
-			"API Error",
-			fmt.Sprintf("Unable to delete key, status code: %d, body: %s", httpResp.StatusCode, string(body)),
-		)
-		return
-	}
 }
 
 func (r *KeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
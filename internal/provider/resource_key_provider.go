@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +13,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/key_providers"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/providers"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &KeyProviderResource{}
@@ -27,13 +32,14 @@ type KeyProviderResource struct {
 }
 
 type KeyProviderResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Type         types.String `tfsdk:"type"`
-	IsDefault    types.Bool   `tfsdk:"is_default"`
-	AWSKMSConfig types.Object `tfsdk:"aws_kms_config"`
-	VaultConfig  types.Object `tfsdk:"vault_config"`
-	CreatedAt    types.String `tfsdk:"created_at"`
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Type         types.String   `tfsdk:"type"`
+	IsDefault    types.Bool     `tfsdk:"is_default"`
+	AWSKMSConfig types.Object   `tfsdk:"aws_kms_config"`
+	VaultConfig  types.Object   `tfsdk:"vault_config"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 // AWSKMSConfigModel describes AWS KMS configuration.
@@ -227,6 +233,13 @@ func (r *KeyProviderResource) Schema(ctx context.Context, req resource.SchemaReq
 				Description: "The timestamp when the key provider was created.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -255,21 +268,27 @@ func (r *KeyProviderResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Build the request payload
-	createReq := map[string]interface{}{
-		"name": data.Name.ValueString(),
-		"type": data.Type.ValueString(),
+	createReq := &models.HandlerCreateProviderHandlerRequest{
+		Name:   data.Name.ValueStringPointer(),
+		Type:   data.Type.ValueStringPointer(),
+		Config: &models.HandlerProviderConfigHandler{},
 	}
 
-	isDefault := 0
 	if !data.IsDefault.IsNull() && data.IsDefault.ValueBool() {
-		isDefault = 1
+		createReq.IsDefault = 1
 	}
-	createReq["isDefault"] = isDefault
 
 	// Add configuration based on type
-	config := make(map[string]interface{})
-
 	if !data.AWSKMSConfig.IsNull() {
 		var awsConfig AWSKMSConfigModel
 		diags := data.AWSKMSConfig.As(ctx, &awsConfig, basetypes.ObjectAsOptions{})
@@ -278,34 +297,17 @@ func (r *KeyProviderResource) Create(ctx context.Context, req resource.CreateReq
 			return
 		}
 
-		awsKmsConfig := map[string]interface{}{
-			"operation": awsConfig.Operation.ValueString(),
-			"awsRegion": awsConfig.AWSRegion.ValueString(),
-		}
-
-		if !awsConfig.AWSAccessKeyID.IsNull() {
-			awsKmsConfig["awsAccessKeyId"] = awsConfig.AWSAccessKeyID.ValueString()
-		}
-		if !awsConfig.AWSSecretAccessKey.IsNull() {
-			awsKmsConfig["awsSecretAccessKey"] = awsConfig.AWSSecretAccessKey.ValueString()
+		createReq.Config.AwsKms = &models.HandlerAWSKMSConfigHandler{
+			Operation:          awsConfig.Operation.ValueStringPointer(),
+			AwsRegion:          awsConfig.AWSRegion.ValueStringPointer(),
+			AwsAccessKeyID:     awsConfig.AWSAccessKeyID.ValueString(),
+			AwsSecretAccessKey: awsConfig.AWSSecretAccessKey.ValueString(),
+			AwsSessionToken:    awsConfig.AWSSessionToken.ValueString(),
+			AssumeRoleArn:      awsConfig.AssumeRoleARN.ValueString(),
+			ExternalID:         awsConfig.ExternalID.ValueString(),
+			EndpointURL:        awsConfig.EndpointURL.ValueString(),
+			KmsKeyAliasPrefix:  awsConfig.KMSKeyAliasPrefix.ValueString(),
 		}
-		if !awsConfig.AWSSessionToken.IsNull() {
-			awsKmsConfig["awsSessionToken"] = awsConfig.AWSSessionToken.ValueString()
-		}
-		if !awsConfig.AssumeRoleARN.IsNull() {
-			awsKmsConfig["assumeRoleArn"] = awsConfig.AssumeRoleARN.ValueString()
-		}
-		if !awsConfig.ExternalID.IsNull() {
-			awsKmsConfig["externalId"] = awsConfig.ExternalID.ValueString()
-		}
-		if !awsConfig.EndpointURL.IsNull() {
-			awsKmsConfig["endpointUrl"] = awsConfig.EndpointURL.ValueString()
-		}
-		if !awsConfig.KMSKeyAliasPrefix.IsNull() {
-			awsKmsConfig["kmsKeyAliasPrefix"] = awsConfig.KMSKeyAliasPrefix.ValueString()
-		}
-
-		config["awsKms"] = awsKmsConfig
 	}
 
 	if !data.VaultConfig.IsNull() {
@@ -316,95 +318,42 @@ func (r *KeyProviderResource) Create(ctx context.Context, req resource.CreateReq
 			return
 		}
 
-		vaultCfg := map[string]interface{}{
-			"operation": vaultConfig.Operation.ValueString(),
-		}
+		createReq.Config.Vault = &models.HandlerVaultConfigHandler{
+			Operation: vaultConfig.Operation.ValueStringPointer(),
 
-		// IMPORT mode fields
-		if !vaultConfig.Address.IsNull() {
-			vaultCfg["address"] = vaultConfig.Address.ValueString()
-		}
-		if !vaultConfig.Token.IsNull() {
-			vaultCfg["token"] = vaultConfig.Token.ValueString()
-		}
-		if !vaultConfig.Mount.IsNull() {
-			vaultCfg["mount"] = vaultConfig.Mount.ValueString()
-		}
-		if !vaultConfig.CACert.IsNull() {
-			vaultCfg["caCert"] = vaultConfig.CACert.ValueString()
-		}
-		if !vaultConfig.Namespace.IsNull() {
-			vaultCfg["namespace"] = vaultConfig.Namespace.ValueString()
-		}
+			// IMPORT mode fields
+			Address:   vaultConfig.Address.ValueString(),
+			Token:     vaultConfig.Token.ValueString(),
+			Mount:     vaultConfig.Mount.ValueString(),
+			CaCert:    vaultConfig.CACert.ValueString(),
+			Namespace: vaultConfig.Namespace.ValueString(),
 
-		// CREATE mode fields
-		if !vaultConfig.Mode.IsNull() {
-			vaultCfg["mode"] = vaultConfig.Mode.ValueString()
-		}
-		if !vaultConfig.Network.IsNull() {
-			vaultCfg["network"] = vaultConfig.Network.ValueString()
-		}
-		if !vaultConfig.Port.IsNull() {
-			vaultCfg["port"] = vaultConfig.Port.ValueInt64()
-		}
-		if !vaultConfig.Version.IsNull() {
-			vaultCfg["version"] = vaultConfig.Version.ValueString()
-		}
+			// CREATE mode fields
+			Mode:    vaultConfig.Mode.ValueString(),
+			Network: vaultConfig.Network.ValueString(),
+			Port:    vaultConfig.Port.ValueInt64(),
+			Version: vaultConfig.Version.ValueString(),
 
-		// PKI configuration
-		if !vaultConfig.PKIMount.IsNull() {
-			vaultCfg["pkiMount"] = vaultConfig.PKIMount.ValueString()
-		}
-		if !vaultConfig.KVMount.IsNull() {
-			vaultCfg["kvMount"] = vaultConfig.KVMount.ValueString()
+			// PKI configuration
+			PkiMount:         vaultConfig.PKIMount.ValueString(),
+			KvMount:          vaultConfig.KVMount.ValueString(),
+			DefaultCertTTL:   vaultConfig.DefaultCertTTL.ValueString(),
+			MaxCertTTL:       vaultConfig.MaxCertTTL.ValueString(),
+			DefaultCACertTTL: vaultConfig.DefaultCACertTTL.ValueString(),
+			MaxCACertTTL:     vaultConfig.MaxCACertTTL.ValueString(),
 		}
-		if !vaultConfig.DefaultCertTTL.IsNull() {
-			vaultCfg["defaultCertTTL"] = vaultConfig.DefaultCertTTL.ValueString()
-		}
-		if !vaultConfig.MaxCertTTL.IsNull() {
-			vaultCfg["maxCertTTL"] = vaultConfig.MaxCertTTL.ValueString()
-		}
-		if !vaultConfig.DefaultCACertTTL.IsNull() {
-			vaultCfg["defaultCACertTTL"] = vaultConfig.DefaultCACertTTL.ValueString()
-		}
-		if !vaultConfig.MaxCACertTTL.IsNull() {
-			vaultCfg["maxCACertTTL"] = vaultConfig.MaxCACertTTL.ValueString()
-		}
-
-		config["vault"] = vaultCfg
 	}
 
-	createReq["config"] = config
-
-	// Debug: Log the request payload
-	requestJSON, _ := json.MarshalIndent(createReq, "", "  ")
-	resp.Diagnostics.AddWarning(
-		"DEBUG: Request Payload",
-		fmt.Sprintf("Sending to POST /key-providers:\n%s", string(requestJSON)),
-	)
-
-	body, err := r.client.DoRequest("POST", "/key-providers", createReq)
+	created, err := r.client.API.Providers.CreateKeyProvider(providers.NewCreateKeyProviderParamsWithContext(ctx).WithProvider(createReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create key provider, got error: %s", err))
 		return
 	}
-
-	var providerResp struct {
-		ID        int64  `json:"id"`
-		Name      string `json:"name"`
-		Type      string `json:"type"`
-		IsDefault int    `json:"isDefault"`
-		CreatedAt string `json:"createdAt"`
-	}
-
-	if err := json.Unmarshal(body, &providerResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse key provider response, got error: %s\nResponse body: %s", err, string(body)))
-		return
-	}
+	providerResp := created.Payload
 
 	data.ID = types.StringValue(fmt.Sprintf("%d", providerResp.ID))
 	data.Name = types.StringValue(providerResp.Name)
-	data.Type = types.StringValue(providerResp.Type)
+	data.Type = types.StringValue(string(providerResp.Type))
 	data.IsDefault = types.BoolValue(providerResp.IsDefault == 1)
 	if providerResp.CreatedAt != "" {
 		data.CreatedAt = types.StringValue(providerResp.CreatedAt)
@@ -449,27 +398,25 @@ func (r *KeyProviderResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/key-providers/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key provider, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var providerResp struct {
-		ID        int64  `json:"id"`
-		Name      string `json:"name"`
-		Type      string `json:"type"`
-		IsDefault int    `json:"isDefault"`
-		CreatedAt string `json:"createdAt"`
-	}
-
-	if err := json.Unmarshal(body, &providerResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse key provider response, got error: %s", err))
+	got, err := r.client.API.Providers.GetKeyProvider(providers.NewGetKeyProviderParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key provider, got error: %s", err))
 		return
 	}
+	providerResp := got.Payload
 
 	data.Name = types.StringValue(providerResp.Name)
-	data.Type = types.StringValue(providerResp.Type)
+	data.Type = types.StringValue(string(providerResp.Type))
 	data.IsDefault = types.BoolValue(providerResp.IsDefault == 1)
 	if providerResp.CreatedAt != "" {
 		data.CreatedAt = types.StringValue(providerResp.CreatedAt)
@@ -494,6 +441,15 @@ func (r *KeyProviderResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
@@ -514,7 +470,22 @@ func (r *KeyProviderResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/key-providers/%s", data.ID.ValueString()), nil)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Providers.DeleteKeyProvider(providers.NewDeleteKeyProviderParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete key provider, got error: %s", err))
 		return
@@ -529,110 +500,89 @@ func (r *KeyProviderResource) ImportState(ctx context.Context, req resource.Impo
 
 // Helper function to get Vault config attribute types
 
-// waitForVaultReady polls the Vault status endpoint until the vault is ready or timeout
+// waitForVaultReady polls the Vault status endpoint until the vault is ready or ctx expires
 func (r *KeyProviderResource) waitForVaultReady(ctx context.Context, providerID int64) error {
-	maxAttempts := 30 // 30 attempts
-	delaySeconds := 2 // 2 seconds between attempts
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if context is cancelled
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("context cancelled while waiting for Vault to be ready")
-		default:
-		}
+	var lastErr error
 
+	for attempt := 1; ; attempt++ {
 		// Call the status endpoint
-		body, err := r.client.DoRequest("GET", fmt.Sprintf("/key-providers/%d/vault/status", providerID), nil)
-		if err != nil {
-			// If it's just not ready yet, continue waiting
-			if attempt < maxAttempts {
-				time.Sleep(time.Duration(delaySeconds) * time.Second)
-				continue
+		got, err := r.client.API.KeyProviders.GetVaultStatus(key_providers.NewGetVaultStatusParamsWithContext(ctx).WithID(providerID), nil)
+		if err == nil {
+			statusResp := got.Payload
+
+			// The status is only logged where API bodies are, and redacted like them
+			fields := map[string]any{"attempt": attempt}
+			if logBodiesFor("/key-providers") {
+				statusJSON, _ := json.Marshal(statusResp)
+				fields["status"] = redactBody(statusJSON)
 			}
-			return fmt.Errorf("failed to get vault status after %d attempts: %s", maxAttempts, err)
-		}
+			tflog.Debug(ctx, "Vault status", fields)
 
-		var statusResp struct {
-			VaultReachable   bool   `json:"vault_reachable"`
-			VaultInitialized bool   `json:"vault_initialized"`
-			Sealed           bool   `json:"sealed"`
-			ContainerRunning bool   `json:"container_running"`
-			VaultStatus      string `json:"vault_status"`
-		}
-
-		if err := json.Unmarshal(body, &statusResp); err != nil {
-			return fmt.Errorf("failed to parse vault status response: %s", err)
-		}
-
-		// Debug logging
-		statusJSON, _ := json.MarshalIndent(statusResp, "", "  ")
-		fmt.Printf("[DEBUG] Vault status (attempt %d/%d):\n%s\n", attempt, maxAttempts, string(statusJSON))
-
-		// Check if vault is ready
-		if statusResp.VaultReachable && statusResp.VaultInitialized && !statusResp.Sealed && statusResp.ContainerRunning {
-			return nil // Vault is ready!
+			// Check if vault is ready
+			if statusResp.VaultReachable && statusResp.VaultInitialized && !statusResp.Sealed && statusResp.ContainerRunning {
+				return nil // Vault is ready!
+			}
+			lastErr = fmt.Errorf("vault status is %q", statusResp.VaultStatus)
+		} else {
+			// If it's just not ready yet, continue waiting
+			lastErr = err
 		}
 
 		// Not ready yet, wait and try again
-		if attempt < maxAttempts {
-			time.Sleep(time.Duration(delaySeconds) * time.Second)
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return fmt.Errorf("timed out waiting for Vault to be ready (%s): %w", lastErr, err)
 		}
 	}
-
-	return fmt.Errorf("vault did not become ready after %d attempts (%d seconds)", maxAttempts, maxAttempts*delaySeconds)
 }
 
-// waitForAWSKMSReady polls the AWS KMS status endpoint until KMS is ready or timeout
+// awsKMSConnectionErrorAttempts is the number of consecutive status checks
+// reporting a connection error after which AWS KMS is considered misconfigured
+// rather than still starting.
+const awsKMSConnectionErrorAttempts = 10
+
+// waitForAWSKMSReady polls the AWS KMS status endpoint until KMS is ready or ctx expires
 func (r *KeyProviderResource) waitForAWSKMSReady(ctx context.Context, providerID int64) error {
-	maxAttempts := 10 // 10 attempts
-	delaySeconds := 2 // 2 seconds between attempts
-
-	for attempt := 1; attempt <= maxAttempts; attempt++ {
-		// Check if context is cancelled
-		select {
-		case <-ctx.Done():
-			return fmt.Errorf("context cancelled while waiting for AWS KMS to be ready")
-		default:
-		}
+	var lastErr error
+	connectionErrors := 0
 
+	for {
 		// Call the status endpoint
-		body, err := r.client.DoRequest("GET", fmt.Sprintf("/key-providers/%d/awskms/status", providerID), nil)
-		if err != nil {
-			// If it's just not ready yet, continue waiting
-			if attempt < maxAttempts {
-				time.Sleep(time.Duration(delaySeconds) * time.Second)
-				continue
+		body, err := r.client.DoRequest(ctx, "GET", fmt.Sprintf("/key-providers/%d/awskms/status", providerID), nil)
+		if err == nil {
+			var statusResp struct {
+				KMSReachable    bool   `json:"kms_reachable"`
+				KMSStatus       string `json:"kms_status"`
+				HasCredentials  bool   `json:"has_credentials"`
+				ConnectionError string `json:"connection_error"`
 			}
-			return fmt.Errorf("failed to get AWS KMS status after %d attempts: %s", maxAttempts, err)
-		}
-
-		var statusResp struct {
-			KMSReachable    bool   `json:"kms_reachable"`
-			KMSStatus       string `json:"kms_status"`
-			HasCredentials  bool   `json:"has_credentials"`
-			ConnectionError string `json:"connection_error"`
-		}
 
-		if err := json.Unmarshal(body, &statusResp); err != nil {
-			return fmt.Errorf("failed to parse AWS KMS status response: %s", err)
-		}
+			if err := json.Unmarshal(body, &statusResp); err != nil {
+				return fmt.Errorf("failed to parse AWS KMS status response: %s", err)
+			}
 
-		// Check if KMS is ready
-		if statusResp.KMSReachable && statusResp.HasCredentials && statusResp.KMSStatus == "available" {
-			return nil // AWS KMS is ready!
-		}
+			// Check if KMS is ready
+			if statusResp.KMSReachable && statusResp.HasCredentials && statusResp.KMSStatus == "available" {
+				return nil // AWS KMS is ready!
+			}
 
-		// If there's a connection error, report it
-		if statusResp.ConnectionError != "" && attempt == maxAttempts {
-			return fmt.Errorf("AWS KMS connection error: %s", statusResp.ConnectionError)
+			// If the connection error persists, report it
+			if statusResp.ConnectionError != "" {
+				connectionErrors++
+				if connectionErrors >= awsKMSConnectionErrorAttempts {
+					return fmt.Errorf("AWS KMS connection error: %s", statusResp.ConnectionError)
+				}
+			} else {
+				connectionErrors = 0
+			}
+			lastErr = fmt.Errorf("AWS KMS status is %q", statusResp.KMSStatus)
+		} else {
+			// If it's just not ready yet, continue waiting
+			lastErr = err
 		}
 
 		// Not ready yet, wait and try again
-		if attempt < maxAttempts {
-			time.Sleep(time.Duration(delaySeconds) * time.Second)
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return fmt.Errorf("timed out waiting for AWS KMS to be ready (%s): %w", lastErr, err)
 		}
 	}
-
-	return fmt.Errorf("AWS KMS did not become ready after %d attempts (%d seconds)", maxAttempts, maxAttempts*delaySeconds)
 }
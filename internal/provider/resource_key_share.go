@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/keys"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/pro"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &KeyShareResource{}
+
+func NewKeyShareResource() resource.Resource {
+	return &KeyShareResource{}
+}
+
+// KeyShareResource shares the public part of a key with another Chainlaunch
+// instance connected through node sharing.
+type KeyShareResource struct {
+	client *Client
+}
+
+type KeyShareResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	KeyID        types.Int64  `tfsdk:"key_id"`
+	ConnectionID types.Int64  `tfsdk:"connection_id"`
+	ExpiresAt    types.String `tfsdk:"expires_at"`
+	Metadata     types.Map    `tfsdk:"metadata"`
+	Status       types.String `tfsdk:"status"`
+	SharedAt     types.String `tfsdk:"shared_at"`
+}
+
+func (r *KeyShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_key_share"
+}
+
+func (r *KeyShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Shares the public key of a Chainlaunch key with another Chainlaunch instance (Chainlaunch Pro), for example so a partner organization can add it to a signature policy. " +
+			"The receiving instance lists it with the chainlaunch_shared_keys data source. " +
+			"Note: Deleting this resource only removes it from Terraform state - the API cannot revoke a key share.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the share.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the key to share.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"connection_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the node sharing connection of the instance to share the key with.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Optional:    true,
+				Description: "The RFC 3339 timestamp after which the share expires. Shares without it do not expire.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional information sent along with the key, such as its purpose.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the share.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shared_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the key was shared.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *KeyShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *KeyShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data KeyShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareReq := &models.ProKeyShareRequest{
+		KeyID:     data.KeyID.ValueInt64Pointer(),
+		PeerID:    data.ConnectionID.ValueInt64Pointer(),
+		ExpiresAt: data.ExpiresAt.ValueString(),
+	}
+	if !data.Metadata.IsNull() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &shareReq.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	shared, err := r.client.API.Pro.ShareKey(pro.NewShareKeyParamsWithContext(ctx).WithRequest(shareReq), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to share key, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(shared.Payload.ShareID)
+	data.Status = stringValueOrNull(shared.Payload.Status)
+	data.SharedAt = stringValueOrNull(shared.Payload.SharedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeyShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data KeyShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API only lists the keys shared with this instance, not the shares
+	// it sent, so the share is kept for as long as its key exists
+	_, err := r.client.API.Keys.GetKeyByID(keys.NewGetKeyByIDParamsWithContext(ctx).WithID(data.KeyID.ValueInt64()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared key, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeyShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The API has no update endpoint for key shares; every attribute that can
+	// be configured forces a replacement, so there is nothing to send here.
+	var data KeyShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *KeyShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The API has no endpoint to revoke a key share, and the receiving
+	// instance only holds the public key, so deletion only removes the
+	// resource from Terraform state; set expires_at to limit a share
+}
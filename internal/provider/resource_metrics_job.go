@@ -2,13 +2,15 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/metrics"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &MetricsJobResource{}
@@ -99,25 +101,11 @@ func (r *MetricsJobResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	jobReq := map[string]interface{}{
-		"job_name":        data.JobName.ValueString(),
-		"targets":         targets,
-		"metrics_path":    data.MetricsPath.ValueString(),
-		"scrape_interval": data.ScrapeInterval.ValueString(),
-	}
-
-	body, err := r.client.DoRequest("POST", "/metrics/job/add", jobReq)
-	if err != nil {
+	if err := r.addJob(ctx, &data, targets); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metrics job, got error: %s", err))
 		return
 	}
 
-	var jobResp map[string]interface{}
-	if err := json.Unmarshal(body, &jobResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse job response: %s", err))
-		return
-	}
-
 	data.ID = types.StringValue(data.JobName.ValueString())
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -132,15 +120,19 @@ func (r *MetricsJobResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// List all jobs and find this one
-	body, err := r.client.DoRequest("GET", "/metrics/jobs", nil)
+	list, err := r.client.API.Metrics.ListJobs(metrics.NewListJobsParamsWithContext(ctx), nil)
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read metrics jobs, got error: %s", err))
 		return
 	}
 
-	var jobs map[string]interface{}
-	if err := json.Unmarshal(body, &jobs); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse jobs response: %s", err))
+	jobs, ok := list.Payload.(map[string]interface{})
+	if !ok {
+		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unexpected jobs response: %T", list.Payload))
 		return
 	}
 
@@ -183,7 +175,7 @@ func (r *MetricsJobResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Delete old job
 	jobName := state.JobName.ValueString()
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/metrics/job/%s", jobName), nil)
+	_, err := r.client.API.Metrics.RemoveGenericJob(metrics.NewRemoveGenericJobParamsWithContext(ctx).WithJobName(jobName), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete old metrics job, got error: %s", err))
 		return
@@ -196,15 +188,7 @@ func (r *MetricsJobResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	jobReq := map[string]interface{}{
-		"job_name":        data.JobName.ValueString(),
-		"targets":         targets,
-		"metrics_path":    data.MetricsPath.ValueString(),
-		"scrape_interval": data.ScrapeInterval.ValueString(),
-	}
-
-	_, err = r.client.DoRequest("POST", "/metrics/job/add", jobReq)
-	if err != nil {
+	if err := r.addJob(ctx, &data, targets); err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metrics job, got error: %s", err))
 		return
 	}
@@ -221,9 +205,21 @@ func (r *MetricsJobResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	jobName := data.JobName.ValueString()
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/metrics/job/%s", jobName), nil)
+	_, err := r.client.API.Metrics.RemoveGenericJob(metrics.NewRemoveGenericJobParamsWithContext(ctx).WithJobName(jobName), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metrics job, got error: %s", err))
 		return
 	}
 }
+
+// addJob registers the scrape job described by data with Prometheus.
+func (r *MetricsJobResource) addJob(ctx context.Context, data *MetricsJobResourceModel, targets []string) error {
+	params := metrics.NewAddGenericJobParamsWithContext(ctx).WithRequest(&models.TypesAddJobRequest{
+		JobName:        data.JobName.ValueStringPointer(),
+		Targets:        targets,
+		MetricsPath:    data.MetricsPath.ValueString(),
+		ScrapeInterval: data.ScrapeInterval.ValueString(),
+	})
+	_, err := r.client.API.Metrics.AddGenericJob(params, nil)
+	return err
+}
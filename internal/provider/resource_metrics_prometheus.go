@@ -10,6 +10,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/metrics"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &MetricsPrometheusResource{}
@@ -113,32 +116,26 @@ func (r *MetricsPrometheusResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	deployReq := map[string]interface{}{
-		"prometheus_version": data.Version.ValueString(),
-		"prometheus_port":    data.Port.ValueInt64(),
-		"scrape_interval":    data.ScrapeInterval.ValueInt64(),
-		"deployment_mode":    data.DeploymentMode.ValueString(),
+	deployReq := &models.TypesDeployPrometheusRequest{
+		PrometheusVersion: data.Version.ValueStringPointer(),
+		PrometheusPort:    data.Port.ValueInt64Pointer(),
+		ScrapeInterval:    data.ScrapeInterval.ValueInt64Pointer(),
+		DeploymentMode:    models.CommonDeploymentMode(data.DeploymentMode.ValueString()),
 	}
 
 	// Add docker config if using docker mode
-	if data.DeploymentMode.ValueString() == "docker" {
-		deployReq["docker_config"] = map[string]interface{}{
-			"network_mode": data.NetworkMode.ValueString(),
+	if deployReq.DeploymentMode == models.CommonDeploymentModeDocker {
+		deployReq.DockerConfig = &models.TypesDockerDeployConfig{
+			NetworkMode: models.CommonNetworkMode(data.NetworkMode.ValueString()),
 		}
 	}
 
-	body, err := r.client.DoRequest("POST", "/metrics/deploy", deployReq)
+	_, err := r.client.API.Metrics.DeployPrometheus(metrics.NewDeployPrometheusParamsWithContext(ctx).WithRequest(deployReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deploy Prometheus, got error: %s", err))
 		return
 	}
 
-	var deployResp map[string]interface{}
-	if err := json.Unmarshal(body, &deployResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse deployment response: %s", err))
-		return
-	}
-
 	// Wait a moment for Prometheus to start
 	// Then read the status
 	if err := r.readStatus(ctx, &data); err != nil {
@@ -200,15 +197,17 @@ func (r *MetricsPrometheusResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Stop Prometheus
-	_, err := r.client.DoRequest("POST", "/metrics/stop", nil)
+	_, err := r.client.API.Metrics.StopPrometheus(metrics.NewStopPrometheusParamsWithContext(ctx), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to stop Prometheus, got error: %s", err))
 		return
 	}
 }
 
+// readStatus uses DoRequest because the generated status model expects objects
+// for deployment_mode, network_mode and scrape_interval, which the API sends as strings.
 func (r *MetricsPrometheusResource) readStatus(ctx context.Context, data *MetricsPrometheusResourceModel) error {
-	body, err := r.client.DoRequest("GET", "/metrics/status", nil)
+	body, err := r.client.DoRequest(ctx, "GET", "/metrics/status", nil)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/besu_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &NetworkResource{}
@@ -106,38 +111,50 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	createReq := CreateNetworkRequest{
-		Name: data.Name.ValueString(),
-		Type: data.Type.ValueString(),
-	}
-
-	if !data.Config.IsNull() && data.Config.ValueString() != "" {
-		var config map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Config.ValueString()), &config); err != nil {
-			resp.Diagnostics.AddError("Config Parse Error", fmt.Sprintf("Unable to parse config JSON: %s", err))
+	var network *models.HTTPNetworkResponse
+	var err error
+	switch strings.ToLower(data.Type.ValueString()) {
+	case "fabric":
+		config := &models.HTTPFabricNetworkConfig{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq := &models.HTTPCreateFabricNetworkRequest{
+			Name:   data.Name.ValueStringPointer(),
+			Config: config,
+		}
+		var created *fabric_networks.CreateFabricNetworkCreated
+		created, err = r.client.API.FabricNetworks.CreateFabricNetwork(fabric_networks.NewCreateFabricNetworkParamsWithContext(ctx).WithRequest(createReq), nil)
+		if err == nil {
+			network = created.Payload
+		}
+	case "besu":
+		config := &models.HTTPCreateBesuNetworkRequestConfig{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, config)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		createReq.Config = config
+		createReq := &models.HTTPCreateBesuNetworkRequest{
+			Name:   data.Name.ValueStringPointer(),
+			Config: config,
+		}
+		var created *besu_networks.CreateBesuNetworkOK
+		created, err = r.client.API.BesuNetworks.CreateBesuNetwork(besu_networks.NewCreateBesuNetworkParamsWithContext(ctx).WithRequest(createReq), nil)
+		if err == nil {
+			network = besuNetworkAsNetwork(created.Payload)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Unsupported Network Type", fmt.Sprintf("Unsupported network type %q: expected fabric or besu.", data.Type.ValueString()))
+		return
 	}
-
-	// Determine the API endpoint based on network type
-	endpoint := fmt.Sprintf("/networks/%s", data.Type.ValueString())
-
-	body, err := r.client.DoRequest("POST", endpoint, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create network, got error: %s", err))
 		return
 	}
 
-	var network Network
-	if err := json.Unmarshal(body, &network); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response, got error: %s", err))
-		return
-	}
-
 	data.ID = types.StringValue(fmt.Sprintf("%d", network.ID))
 	data.Name = types.StringValue(network.Name)
-	data.Type = types.StringValue(network.Type)
 	if network.Status != "" {
 		data.Status = types.StringValue(network.Status)
 	}
@@ -159,22 +176,23 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/%s/%s", data.Type.ValueString(), data.ID.ValueString())
-
-	body, err := r.client.DoRequest("GET", endpoint, nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var network Network
-	if err := json.Unmarshal(body, &network); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response, got error: %s", err))
+	network, err := r.client.getNetwork(ctx, data.Type.ValueString(), id)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read network, got error: %s", err))
 		return
 	}
 
 	data.Name = types.StringValue(network.Name)
-	data.Type = types.StringValue(network.Type)
 	if network.Status != "" {
 		data.Status = types.StringValue(network.Status)
 	}
@@ -207,11 +225,10 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	updateReq := CreateNetworkRequest{
+	updateReq := updateNetworkRequest{
 		Name: data.Name.ValueString(),
 		Type: data.Type.ValueString(),
 	}
-
 	if !data.Config.IsNull() && data.Config.ValueString() != "" {
 		var config map[string]interface{}
 		if err := json.Unmarshal([]byte(data.Config.ValueString()), &config); err != nil {
@@ -223,13 +240,13 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	endpoint := fmt.Sprintf("/networks/%s/%s", data.Type.ValueString(), data.ID.ValueString())
 
-	body, err := r.client.DoRequest("PUT", endpoint, updateReq)
+	body, err := r.client.DoRequest(ctx, "PUT", endpoint, updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update network, got error: %s", err))
 		return
 	}
 
-	var network Network
+	var network models.HTTPNetworkResponse
 	if err := json.Unmarshal(body, &network); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse network response, got error: %s", err))
 		return
@@ -254,9 +271,18 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	endpoint := fmt.Sprintf("/networks/%s/%s", data.Type.ValueString(), data.ID.ValueString())
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
 
-	_, err := r.client.DoRequest("DELETE", endpoint, nil)
+	switch strings.ToLower(data.Type.ValueString()) {
+	case "besu":
+		_, err = r.client.API.BesuNetworks.DeleteBesuNetwork(besu_networks.NewDeleteBesuNetworkParamsWithContext(ctx).WithID(id), nil)
+	default:
+		_, err = r.client.API.FabricNetworks.DeleteFabricNetwork(fabric_networks.NewDeleteFabricNetworkParamsWithContext(ctx).WithID(id), nil)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete network, got error: %s", err))
 		return
@@ -266,3 +292,34 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// getNetwork fetches a network from the endpoint of its type. Besu networks are
+// returned as a models.HTTPNetworkResponse holding the fields both types share.
+func (c *Client) getNetwork(ctx context.Context, networkType string, id int64) (*models.HTTPNetworkResponse, error) {
+	if strings.ToLower(networkType) == "besu" {
+		got, err := c.API.BesuNetworks.GetBesuNetwork(besu_networks.NewGetBesuNetworkParamsWithContext(ctx).WithID(id), nil)
+		if err != nil {
+			return nil, err
+		}
+		return besuNetworkAsNetwork(got.Payload), nil
+	}
+
+	got, err := c.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		return nil, err
+	}
+	return got.Payload, nil
+}
+
+func besuNetworkAsNetwork(n *models.HTTPBesuNetworkResponse) *models.HTTPNetworkResponse {
+	return &models.HTTPNetworkResponse{
+		ID:          n.ID,
+		Name:        n.Name,
+		Description: n.Description,
+		Platform:    n.Platform,
+		Status:      n.Status,
+		Config:      n.Config,
+		CreatedAt:   n.CreatedAt,
+		UpdatedAt:   n.UpdatedAt,
+	}
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/besu_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/fabric_networks"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/pro"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &NetworkShareResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkShareResource{}
+
+func NewNetworkShareResource() resource.Resource {
+	return &NetworkShareResource{}
+}
+
+// NetworkShareResource shares a Fabric or Besu network with other Chainlaunch
+// instances connected through node sharing.
+type NetworkShareResource struct {
+	client *Client
+}
+
+type NetworkShareResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	NetworkID  types.Int64  `tfsdk:"network_id"`
+	Type       types.String `tfsdk:"type"`
+	Recipients types.Set    `tfsdk:"recipients"`
+	Metadata   types.Map    `tfsdk:"metadata"`
+	Status     types.String `tfsdk:"status"`
+}
+
+func (r *NetworkShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_share"
+}
+
+func (r *NetworkShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Shares a Fabric or Besu network (genesis block and configuration) with other Chainlaunch instances (Chainlaunch Pro), " +
+			"once node sharing was set up with chainlaunch_node_invitation and chainlaunch_node_accept_invitation. " +
+			"The other instances accept it with chainlaunch_shared_network_acceptance. Destroying the resource revokes the share.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the share.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the network to share.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("fabric"),
+				Description: "The type of network: fabric (default) or besu.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recipients": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.Int64Type,
+				Description: "IDs of the node sharing connections of the instances to share the network with.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional information sent along with the network, such as a contact or onboarding notes.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the share.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NetworkShareResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var networkType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &networkType)...)
+	if networkType.IsNull() || networkType.IsUnknown() {
+		return
+	}
+	switch networkType.ValueString() {
+	case "fabric", "besu":
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Network Type", fmt.Sprintf("type must be fabric or besu, got %q.", networkType.ValueString()))
+	}
+}
+
+func (r *NetworkShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NetworkShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NetworkShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareReq := &models.ProNetworkShareRequest{
+		NetworkID: data.NetworkID.ValueInt64(),
+	}
+	resp.Diagnostics.Append(data.Recipients.ElementsAs(ctx, &shareReq.Recipients, false)...)
+	if !data.Metadata.IsNull() {
+		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &shareReq.Metadata, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var shared *models.ProNetworkShareResponse
+	if data.Type.ValueString() == "besu" {
+		created, err := r.client.API.Pro.ShareBesuNetwork(pro.NewShareBesuNetworkParamsWithContext(ctx).WithRequest(shareReq), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to share Besu network, got error: %s", err))
+			return
+		}
+		shared = created.Payload
+	} else {
+		created, err := r.client.API.Pro.ShareNetwork(pro.NewShareNetworkParamsWithContext(ctx).WithRequest(shareReq), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to share Fabric network, got error: %s", err))
+			return
+		}
+		shared = created.Payload
+	}
+
+	data.ID = types.StringValue(shared.ShareID)
+	data.Status = stringValueOrNull(shared.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NetworkShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API only lists the networks shared with this instance, not the
+	// shares it sent, so the share is kept for as long as its network exists
+	var err error
+	if data.Type.ValueString() == "besu" {
+		_, err = r.client.API.BesuNetworks.GetBesuNetwork(besu_networks.NewGetBesuNetworkParamsWithContext(ctx).WithID(data.NetworkID.ValueInt64()), nil)
+	} else {
+		_, err = r.client.API.FabricNetworks.GetFabricNetwork(fabric_networks.NewGetFabricNetworkParamsWithContext(ctx).WithID(data.NetworkID.ValueInt64()), nil)
+	}
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared network, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The API has no update endpoint for network shares; every attribute that
+	// can be configured forces a replacement, so there is nothing to send here.
+	var data NetworkShareResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NetworkShareResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.API.Pro.DeleteSharedNetwork(pro.NewDeleteSharedNetworkParamsWithContext(ctx).WithShareID(data.ID.ValueString()), nil)
+	if err != nil {
+		// The share is already gone, for instance revoked outside of Terraform
+		if IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke network share, got error: %s", err))
+		return
+	}
+}
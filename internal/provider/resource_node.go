@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,6 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/nodes"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &NodeResource{}
@@ -114,28 +118,36 @@ func (r *NodeResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	createReq := CreateNodeRequest{
-		Name:     data.Name.ValueString(),
-		Platform: data.Platform.ValueString(),
-		Type:     data.Type.ValueString(),
+	createReq := createNodeRequest{
+		Name:               data.Name.ValueString(),
+		BlockchainPlatform: models.TypesBlockchainPlatform(strings.ToUpper(data.Platform.ValueString())),
 	}
 
-	if !data.Config.IsNull() && data.Config.ValueString() != "" {
-		var config map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Config.ValueString()), &config); err != nil {
-			resp.Diagnostics.AddError("Config Parse Error", fmt.Sprintf("Unable to parse config JSON: %s", err))
-			return
-		}
-		createReq.Config = config
+	// The config JSON is the platform specific configuration selected by type
+	switch nodeType := nodeTypeFromString(data.Type.ValueString()); nodeType {
+	case models.TypesNodeTypeFABRICPEER:
+		createReq.FabricPeer = &models.TypesFabricPeerConfig{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, createReq.FabricPeer)...)
+	case models.TypesNodeTypeFABRICORDERER:
+		createReq.FabricOrderer = &models.TypesFabricOrdererConfig{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, createReq.FabricOrderer)...)
+	case models.TypesNodeTypeBESUFULLNODE:
+		createReq.BesuNode = &models.TypesBesuNodeConfig{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, createReq.BesuNode)...)
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Unsupported Node Type", fmt.Sprintf("Unsupported node type %q: expected peer, orderer or besu.", data.Type.ValueString()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	body, err := r.client.DoRequest("POST", "/nodes", createReq)
+	body, err := r.client.DoRequest(ctx, "POST", "/nodes", createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create node, got error: %s", err))
 		return
 	}
 
-	var node Node
+	var node models.HTTPNodeResponse
 	if err := json.Unmarshal(body, &node); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse node response, got error: %s", err))
 		return
@@ -143,8 +155,6 @@ func (r *NodeResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	data.ID = types.StringValue(fmt.Sprintf("%d", node.ID))
 	data.Name = types.StringValue(node.Name)
-	data.Platform = types.StringValue(node.Platform)
-	data.Type = types.StringValue(node.Type)
 	if node.Status != "" {
 		data.Status = types.StringValue(node.Status)
 	}
@@ -166,21 +176,31 @@ func (r *NodeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var node Node
-	if err := json.Unmarshal(body, &node); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse node response, got error: %s", err))
+	got, err := r.client.API.Nodes.GetNode(nodes.NewGetNodeParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read node, got error: %s", err))
 		return
 	}
+	node := got.Payload
 
 	data.Name = types.StringValue(node.Name)
-	data.Platform = types.StringValue(node.Platform)
-	data.Type = types.StringValue(node.Type)
+	// platform and type keep their configured spelling; only fill them in on import
+	if data.Platform.IsNull() {
+		data.Platform = types.StringValue(node.Platform)
+	}
+	if data.Type.IsNull() {
+		data.Type = types.StringValue(node.NodeType)
+	}
 	if node.Status != "" {
 		data.Status = types.StringValue(node.Status)
 	}
@@ -213,28 +233,33 @@ func (r *NodeResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Preserve created_at from state (it's a computed field that never changes)
 	data.CreatedAt = state.CreatedAt
 
-	updateReq := CreateNodeRequest{
-		Name:     data.Name.ValueString(),
-		Platform: data.Platform.ValueString(),
-		Type:     data.Type.ValueString(),
+	updateReq := updateNodeRequest{
+		Name:               data.Name.ValueString(),
+		BlockchainPlatform: models.TypesBlockchainPlatform(strings.ToUpper(data.Platform.ValueString())),
 	}
 
-	if !data.Config.IsNull() && data.Config.ValueString() != "" {
-		var config map[string]interface{}
-		if err := json.Unmarshal([]byte(data.Config.ValueString()), &config); err != nil {
-			resp.Diagnostics.AddError("Config Parse Error", fmt.Sprintf("Unable to parse config JSON: %s", err))
-			return
-		}
-		updateReq.Config = config
+	switch nodeTypeFromString(data.Type.ValueString()) {
+	case models.TypesNodeTypeFABRICPEER:
+		updateReq.FabricPeer = &updateFabricPeerRequest{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, updateReq.FabricPeer)...)
+	case models.TypesNodeTypeFABRICORDERER:
+		updateReq.FabricOrderer = &updateFabricOrdererRequest{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, updateReq.FabricOrderer)...)
+	case models.TypesNodeTypeBESUFULLNODE:
+		updateReq.BesuNode = &models.HTTPUpdateBesuNodeRequest{}
+		resp.Diagnostics.Append(decodeConfigJSON(data.Config, updateReq.BesuNode)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
+	body, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update node, got error: %s", err))
 		return
 	}
 
-	var node Node
+	var node models.HTTPNodeResponse
 	if err := json.Unmarshal(body, &node); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse node response, got error: %s", err))
 		return
@@ -259,7 +284,13 @@ func (r *NodeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/nodes/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Nodes.DeleteNode(nodes.NewDeleteNodeParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete node, got error: %s", err))
 		return
@@ -269,3 +300,17 @@ func (r *NodeResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 func (r *NodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// nodeTypeFromString maps the type attribute, either the short form (peer,
+// orderer, besu) or the API node type, to the API node type.
+func nodeTypeFromString(value string) models.TypesNodeType {
+	switch strings.ToUpper(strings.ReplaceAll(value, "-", "_")) {
+	case "PEER", "FABRIC_PEER":
+		return models.TypesNodeTypeFABRICPEER
+	case "ORDERER", "FABRIC_ORDERER":
+		return models.TypesNodeTypeFABRICORDERER
+	case "BESU", "BESU_FULLNODE":
+		return models.TypesNodeTypeBESUFULLNODE
+	}
+	return models.TypesNodeType(value)
+}
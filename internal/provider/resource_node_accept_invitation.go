@@ -2,12 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/node_sharing"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &NodeAcceptInvitationResource{}
@@ -82,21 +84,16 @@ func (r *NodeAcceptInvitationResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	acceptReq := NodeAcceptInvitationRequest{
-		InvitationJWT: data.InvitationJWT.ValueString(),
+	acceptReq := &models.CommunicationNodeAcceptInvitationRequest{
+		InvitationJwt: data.InvitationJWT.ValueString(),
 	}
 
-	body, err := r.client.DoRequest("POST", "/node/accept-invitation", acceptReq)
+	accepted, err := r.client.API.NodeSharing.AcceptNodeInvitation(node_sharing.NewAcceptNodeInvitationParamsWithContext(ctx).WithRequest(acceptReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to accept invitation, got error: %s", err))
 		return
 	}
-
-	var acceptResp NodeAcceptInvitationResponse
-	if err := json.Unmarshal(body, &acceptResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse acceptance response: %s", err))
-		return
-	}
+	acceptResp := accepted.Payload
 
 	// Use the first 20 chars of JWT as ID
 	jwt := data.InvitationJWT.ValueString()
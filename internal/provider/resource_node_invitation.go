@@ -2,13 +2,15 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/node_sharing"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &NodeInvitationResource{}
@@ -80,25 +82,20 @@ func (r *NodeInvitationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	createReq := NodeInvitationRequest{
+	createReq := &models.CommunicationNodeInvitationRequest{
 		Bidirectional: data.Bidirectional.ValueBool(),
 	}
 
-	body, err := r.client.DoRequest("POST", "/node/generate-invitation", createReq)
+	generated, err := r.client.API.NodeSharing.GenerateNodeInvitation(node_sharing.NewGenerateNodeInvitationParamsWithContext(ctx).WithRequest(createReq), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to generate invitation, got error: %s", err))
 		return
 	}
-
-	var invResp NodeInvitationResponse
-	if err := json.Unmarshal(body, &invResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse invitation response: %s", err))
-		return
-	}
+	invResp := generated.Payload
 
 	// Use the JWT as the ID (shortened for display)
-	data.ID = types.StringValue(invResp.InvitationJWT[:20] + "...")
-	data.InvitationJWT = types.StringValue(invResp.InvitationJWT)
+	data.ID = types.StringValue(invResp.InvitationJwt[:20] + "...")
+	data.InvitationJWT = types.StringValue(invResp.InvitationJwt)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +13,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/notifications"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var _ resource.Resource = &NotificationProviderResource{}
@@ -224,56 +228,37 @@ func (r *NotificationProviderResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	createReq := map[string]interface{}{
-		"name":                  data.Name.ValueString(),
-		"type":                  data.Type.ValueString(),
-		"is_default":            data.IsDefault.ValueBool(),
-		"notify_backup_success": data.NotifyBackupSuccess.ValueBool(),
-		"notify_backup_failure": data.NotifyBackupFailure.ValueBool(),
-		"notify_node_downtime":  data.NotifyNodeDowntime.ValueBool(),
-		"notify_s3_conn_issue":  data.NotifyS3ConnIssue.ValueBool(),
-		"config":                config,
+	createReq := notificationProviderRequest{
+		Name:                data.Name.ValueString(),
+		Type:                data.Type.ValueString(),
+		IsDefault:           data.IsDefault.ValueBool(),
+		NotifyBackupSuccess: data.NotifyBackupSuccess.ValueBool(),
+		NotifyBackupFailure: data.NotifyBackupFailure.ValueBool(),
+		NotifyNodeDowntime:  data.NotifyNodeDowntime.ValueBool(),
+		NotifyS3ConnIssue:   data.NotifyS3ConnIssue.ValueBool(),
+		Config:              config,
 	}
 
-	body, err := r.client.DoRequest("POST", "/notifications/providers", createReq)
+	body, err := r.client.DoRequest(ctx, "POST", "/notifications/providers", createReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification provider, got error: %s", err))
 		return
 	}
 
-	var providerResp map[string]interface{}
+	var providerResp models.HTTPProviderResponse
 	if err := json.Unmarshal(body, &providerResp); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse provider response: %s", err))
 		return
 	}
 
 	// Set ID and other computed fields
-	if id, ok := providerResp["id"].(float64); ok {
-		data.ID = types.StringValue(fmt.Sprintf("%d", int64(id)))
-	}
-
-	if createdAt, ok := providerResp["createdAt"].(string); ok {
-		data.CreatedAt = types.StringValue(createdAt)
-	}
+	data.ID = types.StringValue(strconv.FormatInt(providerResp.ID, 10))
+	data.CreatedAt = types.StringValue(providerResp.CreatedAt)
 
 	// Set last_test fields to empty if not present (they may be null on creation)
-	if lastTestAt, ok := providerResp["lastTestAt"].(string); ok && lastTestAt != "" {
-		data.LastTestAt = types.StringValue(lastTestAt)
-	} else {
-		data.LastTestAt = types.StringValue("")
-	}
-
-	if lastTestStatus, ok := providerResp["lastTestStatus"].(string); ok && lastTestStatus != "" {
-		data.LastTestStatus = types.StringValue(lastTestStatus)
-	} else {
-		data.LastTestStatus = types.StringValue("")
-	}
-
-	if lastTestMessage, ok := providerResp["lastTestMessage"].(string); ok && lastTestMessage != "" {
-		data.LastTestMessage = types.StringValue(lastTestMessage)
-	} else {
-		data.LastTestMessage = types.StringValue("")
-	}
+	data.LastTestAt = types.StringValue(providerResp.LastTestAt)
+	data.LastTestStatus = types.StringValue(providerResp.LastTestStatus)
+	data.LastTestMessage = types.StringValue(providerResp.LastTestMessage)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -286,61 +271,41 @@ func (r *NotificationProviderResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
-	body, err := r.client.DoRequest("GET", fmt.Sprintf("/notifications/providers/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification provider, got error: %s", err))
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
 		return
 	}
 
-	var providerResp map[string]interface{}
-	if err := json.Unmarshal(body, &providerResp); err != nil {
-		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse provider response: %s", err))
+	fetched, err := r.client.API.Notifications.GetNotificationProvider(notifications.NewGetNotificationProviderParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read notification provider, got error: %s", err))
 		return
 	}
+	providerResp := fetched.Payload
 
 	// Update fields from response
-	if name, ok := providerResp["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
+	data.Name = types.StringValue(providerResp.Name)
+	data.Type = types.StringValue(string(providerResp.Type))
+	data.IsDefault = types.BoolValue(providerResp.IsDefault)
+	data.NotifyBackupSuccess = types.BoolValue(providerResp.NotifyBackupSuccess)
+	data.NotifyBackupFailure = types.BoolValue(providerResp.NotifyBackupFailure)
+	data.NotifyNodeDowntime = types.BoolValue(providerResp.NotifyNodeDowntime)
+	data.NotifyS3ConnIssue = types.BoolValue(providerResp.NotifyS3ConnIssue)
+	data.CreatedAt = types.StringValue(providerResp.CreatedAt)
 
-	if providerType, ok := providerResp["type"].(string); ok {
-		data.Type = types.StringValue(providerType)
+	if providerResp.LastTestAt != "" {
+		data.LastTestAt = types.StringValue(providerResp.LastTestAt)
 	}
-
-	if isDefault, ok := providerResp["isDefault"].(bool); ok {
-		data.IsDefault = types.BoolValue(isDefault)
+	if providerResp.LastTestStatus != "" {
+		data.LastTestStatus = types.StringValue(providerResp.LastTestStatus)
 	}
-
-	if notifyBackupSuccess, ok := providerResp["notifyBackupSuccess"].(bool); ok {
-		data.NotifyBackupSuccess = types.BoolValue(notifyBackupSuccess)
-	}
-
-	if notifyBackupFailure, ok := providerResp["notifyBackupFailure"].(bool); ok {
-		data.NotifyBackupFailure = types.BoolValue(notifyBackupFailure)
-	}
-
-	if notifyNodeDowntime, ok := providerResp["notifyNodeDowntime"].(bool); ok {
-		data.NotifyNodeDowntime = types.BoolValue(notifyNodeDowntime)
-	}
-
-	if notifyS3ConnIssue, ok := providerResp["notifyS3ConnIssue"].(bool); ok {
-		data.NotifyS3ConnIssue = types.BoolValue(notifyS3ConnIssue)
-	}
-
-	if createdAt, ok := providerResp["createdAt"].(string); ok {
-		data.CreatedAt = types.StringValue(createdAt)
-	}
-
-	if lastTestAt, ok := providerResp["lastTestAt"].(string); ok && lastTestAt != "" {
-		data.LastTestAt = types.StringValue(lastTestAt)
-	}
-
-	if lastTestStatus, ok := providerResp["lastTestStatus"].(string); ok && lastTestStatus != "" {
-		data.LastTestStatus = types.StringValue(lastTestStatus)
-	}
-
-	if lastTestMessage, ok := providerResp["lastTestMessage"].(string); ok && lastTestMessage != "" {
-		data.LastTestMessage = types.StringValue(lastTestMessage)
+	if providerResp.LastTestMessage != "" {
+		data.LastTestMessage = types.StringValue(providerResp.LastTestMessage)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -388,40 +353,44 @@ func (r *NotificationProviderResource) Update(ctx context.Context, req resource.
 		return
 	}
 
-	updateReq := map[string]interface{}{
-		"name":                  data.Name.ValueString(),
-		"type":                  data.Type.ValueString(),
-		"is_default":            data.IsDefault.ValueBool(),
-		"notify_backup_success": data.NotifyBackupSuccess.ValueBool(),
-		"notify_backup_failure": data.NotifyBackupFailure.ValueBool(),
-		"notify_node_downtime":  data.NotifyNodeDowntime.ValueBool(),
-		"notify_s3_conn_issue":  data.NotifyS3ConnIssue.ValueBool(),
-		"config":                config,
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	updateReq := notificationProviderRequest{
+		Name:                data.Name.ValueString(),
+		Type:                data.Type.ValueString(),
+		IsDefault:           data.IsDefault.ValueBool(),
+		NotifyBackupSuccess: data.NotifyBackupSuccess.ValueBool(),
+		NotifyBackupFailure: data.NotifyBackupFailure.ValueBool(),
+		NotifyNodeDowntime:  data.NotifyNodeDowntime.ValueBool(),
+		NotifyS3ConnIssue:   data.NotifyS3ConnIssue.ValueBool(),
+		Config:              config,
 	}
 
-	body, err := r.client.DoRequest("PUT", fmt.Sprintf("/notifications/providers/%s", data.ID.ValueString()), updateReq)
+	body, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/notifications/providers/%d", id), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification provider, got error: %s", err))
 		return
 	}
 
-	var providerResp map[string]interface{}
+	var providerResp models.HTTPProviderResponse
 	if err := json.Unmarshal(body, &providerResp); err != nil {
 		resp.Diagnostics.AddError("Parse Error", fmt.Sprintf("Unable to parse provider response: %s", err))
 		return
 	}
 
 	// Update computed fields
-	if lastTestAt, ok := providerResp["lastTestAt"].(string); ok && lastTestAt != "" {
-		data.LastTestAt = types.StringValue(lastTestAt)
+	if providerResp.LastTestAt != "" {
+		data.LastTestAt = types.StringValue(providerResp.LastTestAt)
 	}
-
-	if lastTestStatus, ok := providerResp["lastTestStatus"].(string); ok && lastTestStatus != "" {
-		data.LastTestStatus = types.StringValue(lastTestStatus)
+	if providerResp.LastTestStatus != "" {
+		data.LastTestStatus = types.StringValue(providerResp.LastTestStatus)
 	}
-
-	if lastTestMessage, ok := providerResp["lastTestMessage"].(string); ok && lastTestMessage != "" {
-		data.LastTestMessage = types.StringValue(lastTestMessage)
+	if providerResp.LastTestMessage != "" {
+		data.LastTestMessage = types.StringValue(providerResp.LastTestMessage)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -435,7 +404,13 @@ func (r *NotificationProviderResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/notifications/providers/%s", data.ID.ValueString()), nil)
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Notifications.DeleteNotificationProvider(notifications.NewDeleteNotificationProviderParamsWithContext(ctx).WithID(id), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification provider, got error: %s", err))
 		return
@@ -11,6 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"gopkg.in/yaml.v3"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/plugins"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var (
@@ -142,52 +145,27 @@ func (r *PluginResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Create plugin via API
-	pluginResp, err := r.client.DoRequest("POST", "/plugins", pluginData)
+	// Create plugin via API. The definition is sent as written rather than
+	// through the generated model, which does not cover every plugin field.
+	pluginResp, err := r.client.DoRequest(ctx, "POST", "/plugins", pluginData)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create plugin", err.Error())
 		return
 	}
 
 	// Parse response
-	var pluginResult map[string]interface{}
-	if err := json.Unmarshal(pluginResp, &pluginResult); err != nil {
+	var plugin models.GithubComChainlaunchChainlaunchPkgPluginTypesPlugin
+	if err := json.Unmarshal(pluginResp, &plugin); err != nil {
 		resp.Diagnostics.AddError("Failed to parse plugin response", err.Error())
 		return
 	}
-
-	// Extract metadata
-	metadata, ok := pluginResult["metadata"].(map[string]interface{})
-	if !ok {
+	if plugin.Metadata == nil {
 		resp.Diagnostics.AddError("Invalid plugin response", "Missing metadata field")
 		return
 	}
 
 	// Set computed fields
-	if name, ok := metadata["name"].(string); ok {
-		data.Name = types.StringValue(name)
-	}
-	if apiVersion, ok := pluginResult["apiVersion"].(string); ok {
-		data.APIVersion = types.StringValue(apiVersion)
-	}
-	if kind, ok := pluginResult["kind"].(string); ok {
-		data.Kind = types.StringValue(kind)
-	}
-	if version, ok := metadata["version"].(string); ok {
-		data.MetadataVersion = types.StringValue(version)
-	}
-	if description, ok := metadata["description"].(string); ok {
-		data.Description = types.StringValue(description)
-	}
-	if author, ok := metadata["author"].(string); ok {
-		data.Author = types.StringValue(author)
-	}
-	if repository, ok := metadata["repository"].(string); ok {
-		data.Repository = types.StringValue(repository)
-	}
-	if license, ok := metadata["license"].(string); ok {
-		data.License = types.StringValue(license)
-	}
+	setPluginAttributes(&data, &plugin)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -201,48 +179,22 @@ func (r *PluginResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get plugin from API
-	pluginResp, err := r.client.DoRequest("GET", fmt.Sprintf("/plugins/%s", data.Name.ValueString()), nil)
+	plugin, err := getPlugin(ctx, r.client, data.Name.ValueString())
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Failed to read plugin", err.Error())
 		return
 	}
-
-	// Parse response
-	var pluginResult map[string]interface{}
-	if err := json.Unmarshal(pluginResp, &pluginResult); err != nil {
-		resp.Diagnostics.AddError("Failed to parse plugin response", err.Error())
-		return
-	}
-
-	// Extract metadata
-	metadata, ok := pluginResult["metadata"].(map[string]interface{})
-	if !ok {
+	if plugin.Metadata == nil {
 		resp.Diagnostics.AddError("Invalid plugin response", "Missing metadata field")
 		return
 	}
 
 	// Update computed fields
-	if apiVersion, ok := pluginResult["apiVersion"].(string); ok {
-		data.APIVersion = types.StringValue(apiVersion)
-	}
-	if kind, ok := pluginResult["kind"].(string); ok {
-		data.Kind = types.StringValue(kind)
-	}
-	if version, ok := metadata["version"].(string); ok {
-		data.MetadataVersion = types.StringValue(version)
-	}
-	if description, ok := metadata["description"].(string); ok {
-		data.Description = types.StringValue(description)
-	}
-	if author, ok := metadata["author"].(string); ok {
-		data.Author = types.StringValue(author)
-	}
-	if repository, ok := metadata["repository"].(string); ok {
-		data.Repository = types.StringValue(repository)
-	}
-	if license, ok := metadata["license"].(string); ok {
-		data.License = types.StringValue(license)
-	}
+	setPluginAttributes(&data, plugin)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -281,49 +233,26 @@ func (r *PluginResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Update plugin via API
-	pluginResp, err := r.client.DoRequest("PUT", fmt.Sprintf("/plugins/%s", data.Name.ValueString()), pluginData)
+	// Update plugin via API, sending the definition as written (see Create)
+	pluginResp, err := r.client.DoRequest(ctx, "PUT", fmt.Sprintf("/plugins/%s", data.Name.ValueString()), pluginData)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update plugin", err.Error())
 		return
 	}
 
 	// Parse response
-	var pluginResult map[string]interface{}
-	if err := json.Unmarshal(pluginResp, &pluginResult); err != nil {
+	var plugin models.GithubComChainlaunchChainlaunchPkgPluginTypesPlugin
+	if err := json.Unmarshal(pluginResp, &plugin); err != nil {
 		resp.Diagnostics.AddError("Failed to parse plugin response", err.Error())
 		return
 	}
-
-	// Extract metadata
-	metadata, ok := pluginResult["metadata"].(map[string]interface{})
-	if !ok {
+	if plugin.Metadata == nil {
 		resp.Diagnostics.AddError("Invalid plugin response", "Missing metadata field")
 		return
 	}
 
 	// Update computed fields
-	if apiVersion, ok := pluginResult["apiVersion"].(string); ok {
-		data.APIVersion = types.StringValue(apiVersion)
-	}
-	if kind, ok := pluginResult["kind"].(string); ok {
-		data.Kind = types.StringValue(kind)
-	}
-	if version, ok := metadata["version"].(string); ok {
-		data.MetadataVersion = types.StringValue(version)
-	}
-	if description, ok := metadata["description"].(string); ok {
-		data.Description = types.StringValue(description)
-	}
-	if author, ok := metadata["author"].(string); ok {
-		data.Author = types.StringValue(author)
-	}
-	if repository, ok := metadata["repository"].(string); ok {
-		data.Repository = types.StringValue(repository)
-	}
-	if license, ok := metadata["license"].(string); ok {
-		data.License = types.StringValue(license)
-	}
+	setPluginAttributes(&data, &plugin)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -337,13 +266,26 @@ func (r *PluginResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	// Delete plugin via API
-	_, err := r.client.DoRequest("DELETE", fmt.Sprintf("/plugins/%s", data.Name.ValueString()), nil)
+	_, err := r.client.API.Plugins.DeletePlugin(plugins.NewDeletePluginParamsWithContext(ctx).WithName(data.Name.ValueString()), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to delete plugin", err.Error())
 		return
 	}
 }
 
+// setPluginAttributes copies the computed attributes of a plugin definition
+// into data. plugin.Metadata must not be nil.
+func setPluginAttributes(data *PluginResourceModel, plugin *models.GithubComChainlaunchChainlaunchPkgPluginTypesPlugin) {
+	data.Name = types.StringValue(plugin.Metadata.Name)
+	data.APIVersion = types.StringValue(plugin.APIVersion)
+	data.Kind = types.StringValue(plugin.Kind)
+	data.MetadataVersion = types.StringValue(plugin.Metadata.Version)
+	data.Description = types.StringValue(plugin.Metadata.Description)
+	data.Author = types.StringValue(plugin.Metadata.Author)
+	data.Repository = types.StringValue(plugin.Metadata.Repository)
+	data.License = types.StringValue(plugin.Metadata.License)
+}
+
 func (r *PluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
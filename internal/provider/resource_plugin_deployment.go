@@ -4,12 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/plugins"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
 )
 
 var (
@@ -26,14 +29,15 @@ type PluginDeploymentResource struct {
 }
 
 type PluginDeploymentResourceModel struct {
-	PluginName  types.String `tfsdk:"plugin_name"`
-	Parameters  types.String `tfsdk:"parameters"`
-	Status      types.String `tfsdk:"status"`
-	ProjectName types.String `tfsdk:"project_name"`
-	StartedAt   types.String `tfsdk:"started_at"`
-	StoppedAt   types.String `tfsdk:"stopped_at"`
-	Error       types.String `tfsdk:"error"`
-	ID          types.String `tfsdk:"id"`
+	PluginName  types.String   `tfsdk:"plugin_name"`
+	Parameters  types.String   `tfsdk:"parameters"`
+	Status      types.String   `tfsdk:"status"`
+	ProjectName types.String   `tfsdk:"project_name"`
+	StartedAt   types.String   `tfsdk:"started_at"`
+	StoppedAt   types.String   `tfsdk:"stopped_at"`
+	Error       types.String   `tfsdk:"error"`
+	ID          types.String   `tfsdk:"id"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *PluginDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -77,6 +81,13 @@ func (r *PluginDeploymentResource) Schema(ctx context.Context, req resource.Sche
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -105,6 +116,15 @@ func (r *PluginDeploymentResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Parse parameters JSON
 	var parameters map[string]interface{}
 	if err := json.Unmarshal([]byte(data.Parameters.ValueString()), &parameters); err != nil {
@@ -113,7 +133,8 @@ func (r *PluginDeploymentResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Deploy plugin
-	_, err := r.client.DoRequest("POST", fmt.Sprintf("/plugins/%s/deploy", data.PluginName.ValueString()), parameters)
+	params := plugins.NewDeployPluginParamsWithContext(ctx).WithName(data.PluginName.ValueString()).WithParameters(parameters)
+	_, err := r.client.API.Plugins.DeployPlugin(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to deploy plugin", err.Error())
 		return
@@ -123,7 +144,7 @@ func (r *PluginDeploymentResource) Create(ctx context.Context, req resource.Crea
 	data.ID = data.PluginName
 
 	// Wait for deployment to start and get status
-	if err := r.waitForDeploymentReady(ctx, data.PluginName.ValueString(), 60*time.Second); err != nil {
+	if err := r.waitForDeploymentReady(ctx, data.PluginName.ValueString()); err != nil {
 		resp.Diagnostics.AddWarning(
 			"Deployment Status Check",
 			fmt.Sprintf("Could not verify deployment status: %v. The deployment may still be in progress.", err),
@@ -131,34 +152,11 @@ func (r *PluginDeploymentResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Get deployment status
-	statusResp, err := r.client.DoRequest("GET", fmt.Sprintf("/plugins/%s/deployment-status", data.PluginName.ValueString()), nil)
+	status, err := r.getDeploymentStatus(ctx, data.PluginName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddWarning("Failed to get deployment status", err.Error())
 	} else {
-		var statusResult map[string]interface{}
-		if err := json.Unmarshal(statusResp, &statusResult); err == nil {
-			if status, ok := statusResult["status"].(string); ok {
-				data.Status = types.StringValue(status)
-			}
-			if projectName, ok := statusResult["projectName"].(string); ok {
-				data.ProjectName = types.StringValue(projectName)
-			}
-			if startedAt, ok := statusResult["startedAt"].(string); ok && startedAt != "" {
-				data.StartedAt = types.StringValue(startedAt)
-			} else {
-				data.StartedAt = types.StringValue("")
-			}
-			if stoppedAt, ok := statusResult["stoppedAt"].(string); ok && stoppedAt != "" {
-				data.StoppedAt = types.StringValue(stoppedAt)
-			} else {
-				data.StoppedAt = types.StringValue("")
-			}
-			if errorMsg, ok := statusResult["error"].(string); ok && errorMsg != "" {
-				data.Error = types.StringValue(errorMsg)
-			} else {
-				data.Error = types.StringValue("")
-			}
-		}
+		setPluginDeploymentStatus(&data, status)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -173,34 +171,18 @@ func (r *PluginDeploymentResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get deployment status
-	statusResp, err := r.client.DoRequest("GET", fmt.Sprintf("/plugins/%s/deployment-status", data.PluginName.ValueString()), nil)
+	status, err := r.getDeploymentStatus(ctx, data.PluginName.ValueString())
 	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError("Failed to get deployment status", err.Error())
 		return
 	}
 
-	var statusResult map[string]interface{}
-	if err := json.Unmarshal(statusResp, &statusResult); err != nil {
-		resp.Diagnostics.AddError("Failed to parse deployment status", err.Error())
-		return
-	}
-
 	// Update status fields
-	if status, ok := statusResult["status"].(string); ok {
-		data.Status = types.StringValue(status)
-	}
-	if projectName, ok := statusResult["projectName"].(string); ok {
-		data.ProjectName = types.StringValue(projectName)
-	}
-	if startedAt, ok := statusResult["startedAt"].(string); ok && startedAt != "" {
-		data.StartedAt = types.StringValue(startedAt)
-	}
-	if stoppedAt, ok := statusResult["stoppedAt"].(string); ok && stoppedAt != "" {
-		data.StoppedAt = types.StringValue(stoppedAt)
-	}
-	if errorMsg, ok := statusResult["error"].(string); ok && errorMsg != "" {
-		data.Error = types.StringValue(errorMsg)
-	}
+	setPluginDeploymentStatus(&data, status)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -221,11 +203,20 @@ func (r *PluginDeploymentResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Preserve ID
 	data.ID = state.ID
 
 	// Stop current deployment
-	_, err := r.client.DoRequest("POST", fmt.Sprintf("/plugins/%s/stop", data.PluginName.ValueString()), nil)
+	_, err := r.client.API.Plugins.StopPlugin(plugins.NewStopPluginParamsWithContext(ctx).WithName(data.PluginName.ValueString()), nil)
 	if err != nil {
 		resp.Diagnostics.AddWarning("Failed to stop plugin", err.Error())
 	}
@@ -238,14 +229,15 @@ func (r *PluginDeploymentResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Redeploy with new parameters
-	_, err = r.client.DoRequest("POST", fmt.Sprintf("/plugins/%s/deploy", data.PluginName.ValueString()), parameters)
+	params := plugins.NewDeployPluginParamsWithContext(ctx).WithName(data.PluginName.ValueString()).WithParameters(parameters)
+	_, err = r.client.API.Plugins.DeployPlugin(params, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to redeploy plugin", err.Error())
 		return
 	}
 
 	// Wait for deployment to start
-	if err := r.waitForDeploymentReady(ctx, data.PluginName.ValueString(), 60*time.Second); err != nil {
+	if err := r.waitForDeploymentReady(ctx, data.PluginName.ValueString()); err != nil {
 		resp.Diagnostics.AddWarning(
 			"Deployment Status Check",
 			fmt.Sprintf("Could not verify deployment status: %v", err),
@@ -253,34 +245,11 @@ func (r *PluginDeploymentResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Get updated deployment status
-	statusResp, err := r.client.DoRequest("GET", fmt.Sprintf("/plugins/%s/deployment-status", data.PluginName.ValueString()), nil)
+	status, err := r.getDeploymentStatus(ctx, data.PluginName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddWarning("Failed to get deployment status", err.Error())
 	} else {
-		var statusResult map[string]interface{}
-		if err := json.Unmarshal(statusResp, &statusResult); err == nil {
-			if status, ok := statusResult["status"].(string); ok {
-				data.Status = types.StringValue(status)
-			}
-			if projectName, ok := statusResult["projectName"].(string); ok {
-				data.ProjectName = types.StringValue(projectName)
-			}
-			if startedAt, ok := statusResult["startedAt"].(string); ok && startedAt != "" {
-				data.StartedAt = types.StringValue(startedAt)
-			} else {
-				data.StartedAt = types.StringValue("")
-			}
-			if stoppedAt, ok := statusResult["stoppedAt"].(string); ok && stoppedAt != "" {
-				data.StoppedAt = types.StringValue(stoppedAt)
-			} else {
-				data.StoppedAt = types.StringValue("")
-			}
-			if errorMsg, ok := statusResult["error"].(string); ok && errorMsg != "" {
-				data.Error = types.StringValue(errorMsg)
-			} else {
-				data.Error = types.StringValue("")
-			}
-		}
+		setPluginDeploymentStatus(&data, status)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -294,8 +263,17 @@ func (r *PluginDeploymentResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Stop plugin deployment
-	_, err := r.client.DoRequest("POST", fmt.Sprintf("/plugins/%s/stop", data.PluginName.ValueString()), nil)
+	_, err := r.client.API.Plugins.StopPlugin(plugins.NewStopPluginParamsWithContext(ctx).WithName(data.PluginName.ValueString()), nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to stop plugin deployment", err.Error())
 		return
@@ -306,30 +284,34 @@ func (r *PluginDeploymentResource) ImportState(ctx context.Context, req resource
 	resource.ImportStatePassthroughID(ctx, path.Root("plugin_name"), req, resp)
 }
 
-// waitForDeploymentReady waits for the plugin deployment to reach a ready state
-func (r *PluginDeploymentResource) waitForDeploymentReady(ctx context.Context, pluginName string, timeout time.Duration) error {
-	deadline := time.Now().Add(timeout)
-	checkInterval := 2 * time.Second
-
-	for time.Now().Before(deadline) {
-		statusResp, err := r.client.DoRequest("GET", fmt.Sprintf("/plugins/%s/deployment-status", pluginName), nil)
-		if err == nil {
-			var statusResult map[string]interface{}
-			if err := json.Unmarshal(statusResp, &statusResult); err == nil {
-				status, ok := statusResult["status"].(string)
-				if ok && (status == "deployed" || status == "error") {
-					return nil
-				}
-			}
+// waitForDeploymentReady waits for the plugin deployment to reach a ready state or ctx to expire
+func (r *PluginDeploymentResource) waitForDeploymentReady(ctx context.Context, pluginName string) error {
+	for {
+		status, err := r.getDeploymentStatus(ctx, pluginName)
+		if err == nil && (status.Status == "deployed" || status.Status == "error") {
+			return nil
 		}
 
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(checkInterval):
-			// Continue waiting
+		if err := sleepWithContext(ctx, statusPollInterval); err != nil {
+			return fmt.Errorf("timeout waiting for deployment to be ready: %w", err)
 		}
 	}
+}
+
+// getDeploymentStatus fetches the deployment status of a plugin.
+func (r *PluginDeploymentResource) getDeploymentStatus(ctx context.Context, pluginName string) (*models.TypesDeploymentStatus, error) {
+	status, err := r.client.API.Plugins.GetDeploymentStatus(plugins.NewGetDeploymentStatusParamsWithContext(ctx).WithName(pluginName), nil)
+	if err != nil {
+		return nil, err
+	}
+	return status.Payload, nil
+}
 
-	return fmt.Errorf("timeout waiting for deployment to be ready")
+// setPluginDeploymentStatus copies a deployment status into data.
+func setPluginDeploymentStatus(data *PluginDeploymentResourceModel, status *models.TypesDeploymentStatus) {
+	data.Status = types.StringValue(status.Status)
+	data.ProjectName = types.StringValue(status.ProjectName)
+	data.StartedAt = types.StringValue(status.StartedAt)
+	data.StoppedAt = types.StringValue(status.StoppedAt)
+	data.Error = types.StringValue(status.Error)
 }
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Resources whose Read does not query the Chainlaunch API
+var readWithoutAPIResources = map[string]string{
+	"chainlaunch_fabric_chaincode_install": "installation is a one-shot lifecycle action",
+	"chainlaunch_fabric_chaincode_approve": "approval is a one-shot lifecycle action",
+	"chainlaunch_fabric_chaincode_commit":  "commit is a one-shot lifecycle action",
+	"chainlaunch_fabric_chaincode_deploy":  "deployment is a one-shot lifecycle action",
+	"chainlaunch_node_invitation":          "invitations have no server-side state",
+	"chainlaunch_node_accept_invitation":   "acceptance is a one-shot action",
+	"chainlaunch_external_nodes_sync":      "sync failures are reported as warnings",
+}
+
+// TestResourceReadRemovesResourceOnNotFound checks that every resource drops
+// itself from state when the API reports the object as deleted, so that
+// Terraform plans a re-create instead of failing the refresh.
+func TestResourceReadRemovesResourceOnNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "", "test", "test")
+	p := &ChainlaunchProvider{}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "chainlaunch"}, metadataResp)
+
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			if reason, ok := readWithoutAPIResources[metadataResp.TypeName]; ok {
+				t.Skip(reason)
+			}
+
+			if rc, ok := r.(resource.ResourceWithConfigure); ok {
+				configureResp := &resource.ConfigureResponse{}
+				rc.Configure(ctx, resource.ConfigureRequest{ProviderData: client}, configureResp)
+				if configureResp.Diagnostics.HasError() {
+					t.Fatalf("Configure returned errors: %v", configureResp.Diagnostics)
+				}
+			}
+
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+			state := testExistingResourceState(ctx, t, schemaResp.Schema)
+			readResp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read returned errors: %v", readResp.Diagnostics)
+			}
+			if !readResp.State.Raw.IsNull() {
+				t.Errorf("expected %s to be removed from state on 404", metadataResp.TypeName)
+			}
+		})
+	}
+}
+
+// testExistingResourceState builds a state in which every primitive top-level
+// attribute is set (so IDs and lookup keys are present) and nested attributes
+// are null.
+func testExistingResourceState(ctx context.Context, t *testing.T, s schema.Schema) tfsdk.State {
+	t.Helper()
+
+	objectType, ok := s.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatalf("unexpected schema type %T", s.Type().TerraformType(ctx))
+	}
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		switch {
+		case attrType.Is(tftypes.String):
+			values[name] = tftypes.NewValue(tftypes.String, "1")
+		case attrType.Is(tftypes.Number):
+			values[name] = tftypes.NewValue(tftypes.Number, 1)
+		case attrType.Is(tftypes.Bool):
+			values[name] = tftypes.NewValue(tftypes.Bool, false)
+		default:
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/users"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &RoleBindingResource{}
+var _ resource.ResourceWithValidateConfig = &RoleBindingResource{}
+
+func NewRoleBindingResource() resource.Resource {
+	return &RoleBindingResource{}
+}
+
+// RoleBindingResource assigns a role to a user that is not managed by
+// chainlaunch_user, such as the bootstrap administrator.
+type RoleBindingResource struct {
+	client *Client
+}
+
+type RoleBindingResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	UserID       types.Int64  `tfsdk:"user_id"`
+	Role         types.String `tfsdk:"role"`
+	Username     types.String `tfsdk:"username"`
+	PreviousRole types.String `tfsdk:"previous_role"`
+}
+
+func (r *RoleBindingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_binding"
+}
+
+func (r *RoleBindingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a role to an existing Chainlaunch user. The role the user had before is restored on destroy. " +
+			"Do not bind a role to a user managed by chainlaunch_user, whose role attribute already manages it.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the binding, the ID of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.Int64Attribute{
+				Required:    true,
+				Description: "The ID of the user, for example from the chainlaunch_users data source.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role to assign: ADMIN, OPERATOR, VIEWER, CUSTOM or MCP.",
+			},
+			"username": schema.StringAttribute{
+				Computed:    true,
+				Description: "The login name of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_role": schema.StringAttribute{
+				Computed:    true,
+				Description: "The role of the user before the binding was created, restored on destroy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RoleBindingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var role types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("role"), &role)...)
+	if err := validateUserRole(role); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "Invalid Role", err.Error())
+	}
+}
+
+func (r *RoleBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoleBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userID := data.UserID.ValueInt64()
+	got, err := r.client.API.Users.GetUser(users.NewGetUserParamsWithContext(ctx).WithID(userID), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %d, got error: %s", userID, err))
+		return
+	}
+	data.PreviousRole = types.StringValue(string(got.Payload.Role))
+
+	updated, err := r.client.API.Users.UpdateUserRole(users.NewUpdateUserRoleParamsWithContext(ctx).WithID(userID).WithRequest(&models.AuthUpdateUserRequest{
+		Role: models.AuthRole(data.Role.ValueString()),
+	}), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user role, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(userID, 10))
+	data.Role = types.StringValue(string(updated.Payload.Role))
+	data.Username = types.StringValue(updated.Payload.Username)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, err := r.client.API.Users.GetUser(users.NewGetUserParamsWithContext(ctx).WithID(data.UserID.ValueInt64()), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(string(got.Payload.Role))
+	data.Username = types.StringValue(got.Payload.Username)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updated, err := r.client.API.Users.UpdateUserRole(users.NewUpdateUserRoleParamsWithContext(ctx).WithID(data.UserID.ValueInt64()).WithRequest(&models.AuthUpdateUserRequest{
+		Role: models.AuthRole(data.Role.ValueString()),
+	}), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user role, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(string(updated.Payload.Role))
+	data.Username = types.StringValue(updated.Payload.Username)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoleBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoleBindingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Users always have a role, so destroying the binding restores the one it replaced
+	_, err := r.client.API.Users.UpdateUserRole(users.NewUpdateUserRoleParamsWithContext(ctx).WithID(data.UserID.ValueInt64()).WithRequest(&models.AuthUpdateUserRequest{
+		Role: models.AuthRole(data.PreviousRole.ValueString()),
+	}), nil)
+	if err != nil {
+		// A user deleted outside of Terraform has no role left to restore
+		if IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore user role, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/settings"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &SettingsResource{}
+var _ resource.ResourceWithImportState = &SettingsResource{}
+var _ resource.ResourceWithValidateConfig = &SettingsResource{}
+
+func NewSettingsResource() resource.Resource {
+	return &SettingsResource{}
+}
+
+// SettingsResource manages the instance-wide settings. There is a single
+// settings object per instance, so only one instance of this resource should
+// exist per provider configuration.
+type SettingsResource struct {
+	client *Client
+}
+
+type SettingsResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	DefaultNodeExposeIP  types.String `tfsdk:"default_node_expose_ip"`
+	HealthCheckInterval  types.String `tfsdk:"health_check_interval"`
+	HealthCheckTimeout   types.String `tfsdk:"health_check_timeout"`
+	HealthCheckRetention types.Int64  `tfsdk:"health_check_retention"`
+	DemoMode             types.Bool   `tfsdk:"demo_mode"`
+	PeerTemplateCMD      types.String `tfsdk:"peer_template_cmd"`
+	OrdererTemplateCMD   types.String `tfsdk:"orderer_template_cmd"`
+	BesuTemplateCMD      types.String `tfsdk:"besu_template_cmd"`
+	PreviousConfig       types.String `tfsdk:"previous_config"`
+	UpdatedAt            types.String `tfsdk:"updated_at"`
+}
+
+// settingsID is the ID of the settings singleton.
+const settingsID = "settings"
+
+func (r *SettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_settings"
+}
+
+func (r *SettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	optionalString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages the instance-wide Chainlaunch settings. There is one settings object per instance, so declare this resource once. " +
+			"Attributes that are not set keep their current value, changes made outside Terraform show up as drift, and the settings found when the resource was created are restored on destroy.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the settings singleton, always \"settings\".",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_node_expose_ip": optionalString("The IP address new nodes are exposed on by default."),
+			"health_check_interval":  optionalString("How often nodes are health checked, as a duration such as 30s or 1m."),
+			"health_check_timeout":   optionalString("The timeout of a single health check, as a duration such as 10s."),
+			"health_check_retention": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "How many days health check records are kept.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"demo_mode": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether demo mode, with anonymous read-only access, is enabled.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"peer_template_cmd":    optionalString("The command template used to start Fabric peers."),
+			"orderer_template_cmd": optionalString("The command template used to start Fabric orderers."),
+			"besu_template_cmd":    optionalString("The command template used to start Besu nodes."),
+			"previous_config": schema.StringAttribute{
+				Computed:    true,
+				Description: "The settings found when the resource was created, as JSON, restored on destroy.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the settings were last updated.",
+			},
+		},
+	}
+}
+
+func (r *SettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	durations := []struct {
+		attribute string
+		value     types.String
+	}{
+		{"health_check_interval", data.HealthCheckInterval},
+		{"health_check_timeout", data.HealthCheckTimeout},
+	}
+	for _, duration := range durations {
+		if duration.value.IsNull() || duration.value.IsUnknown() {
+			continue
+		}
+		if d, err := time.ParseDuration(duration.value.ValueString()); err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(duration.attribute),
+				"Invalid Duration",
+				fmt.Sprintf("%s must be a positive duration such as '30s' or '1m', got %q.", duration.attribute, duration.value.ValueString()),
+			)
+		}
+	}
+
+	if !data.HealthCheckRetention.IsNull() && !data.HealthCheckRetention.IsUnknown() && data.HealthCheckRetention.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("health_check_retention"), "Invalid Retention", "health_check_retention must be at least 1 day.")
+	}
+}
+
+func (r *SettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.getSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		return
+	}
+
+	previous, err := json.Marshal(current)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode current settings, got error: %s", err))
+		return
+	}
+	data.PreviousConfig = types.StringValue(string(previous))
+	data.ID = types.StringValue(settingsID)
+
+	var configured SettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, configured, &data, current, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	got, err := r.client.API.Settings.GetSetting(settings.NewGetSettingParamsWithContext(ctx), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		return
+	}
+
+	setSettings(&data, got.Payload)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.getSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings, got error: %s", err))
+		return
+	}
+
+	var configured SettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, configured, &data, current, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Settings cannot be deleted; put back the ones found on creation. An
+	// imported resource has nothing to restore and leaves the settings as they are.
+	if data.PreviousConfig.IsNull() || data.PreviousConfig.ValueString() == "" {
+		return
+	}
+
+	var previous models.ServiceSettingConfig
+	if err := json.Unmarshal([]byte(data.PreviousConfig.ValueString()), &previous); err != nil {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unable to decode previous_config, got error: %s", err))
+		return
+	}
+
+	_, err := r.client.API.Settings.CreateOrUpdateSetting(settings.NewCreateOrUpdateSettingParamsWithContext(ctx).
+		WithSetting(&models.ServiceCreateSettingParams{Config: &previous}), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore settings, got error: %s", err))
+		return
+	}
+}
+
+func (r *SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getSettings returns the current settings, or empty settings when none were saved yet.
+func (r *SettingsResource) getSettings(ctx context.Context) (*models.ServiceSettingConfig, error) {
+	got, err := r.client.API.Settings.GetSetting(settings.NewGetSettingParamsWithContext(ctx), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return &models.ServiceSettingConfig{}, nil
+		}
+		return nil, err
+	}
+	if got.Payload == nil || got.Payload.Config == nil {
+		return &models.ServiceSettingConfig{}, nil
+	}
+	return got.Payload.Config, nil
+}
+
+// apply overlays the configured attributes onto the current settings and
+// saves the result, since the API replaces the whole configuration. Attributes
+// left out of the configuration keep their current value.
+func (r *SettingsResource) apply(ctx context.Context, configured SettingsResourceModel, data *SettingsResourceModel, current *models.ServiceSettingConfig, diags *diag.Diagnostics) {
+	config := *current
+	if !configured.DefaultNodeExposeIP.IsNull() {
+		config.DefaultNodeExposeIP = configured.DefaultNodeExposeIP.ValueString()
+	}
+	if !configured.HealthCheckInterval.IsNull() {
+		config.HealthCheckInterval = configured.HealthCheckInterval.ValueString()
+	}
+	if !configured.HealthCheckTimeout.IsNull() {
+		config.HealthCheckTimeout = configured.HealthCheckTimeout.ValueString()
+	}
+	if !configured.HealthCheckRetention.IsNull() {
+		config.HealthCheckRetention = configured.HealthCheckRetention.ValueInt64()
+	}
+	if !configured.DemoMode.IsNull() {
+		config.DemoMode = configured.DemoMode.ValueBool()
+	}
+	if !configured.PeerTemplateCMD.IsNull() {
+		config.PeerTemplateCMD = configured.PeerTemplateCMD.ValueString()
+	}
+	if !configured.OrdererTemplateCMD.IsNull() {
+		config.OrdererTemplateCMD = configured.OrdererTemplateCMD.ValueString()
+	}
+	if !configured.BesuTemplateCMD.IsNull() {
+		config.BesuTemplateCMD = configured.BesuTemplateCMD.ValueString()
+	}
+
+	saved, err := r.client.API.Settings.CreateOrUpdateSetting(settings.NewCreateOrUpdateSettingParamsWithContext(ctx).
+		WithSetting(&models.ServiceCreateSettingParams{Config: &config}), nil)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to save settings, got error: %s", err))
+		return
+	}
+
+	setSettings(data, saved.Payload)
+}
+
+// setSettings copies the settings returned by the API into data.
+func setSettings(data *SettingsResourceModel, setting *models.ServiceSetting) {
+	config := &models.ServiceSettingConfig{}
+	if setting != nil && setting.Config != nil {
+		config = setting.Config
+	}
+
+	data.ID = types.StringValue(settingsID)
+	data.DefaultNodeExposeIP = stringValueOrNull(config.DefaultNodeExposeIP)
+	data.HealthCheckInterval = refreshDuration(data.HealthCheckInterval, config.HealthCheckInterval)
+	data.HealthCheckTimeout = refreshDuration(data.HealthCheckTimeout, config.HealthCheckTimeout)
+	data.HealthCheckRetention = types.Int64Value(config.HealthCheckRetention)
+	data.DemoMode = types.BoolValue(config.DemoMode)
+	data.PeerTemplateCMD = stringValueOrNull(config.PeerTemplateCMD)
+	data.OrdererTemplateCMD = stringValueOrNull(config.OrdererTemplateCMD)
+	data.BesuTemplateCMD = stringValueOrNull(config.BesuTemplateCMD)
+	data.UpdatedAt = types.StringNull()
+	if setting != nil {
+		data.UpdatedAt = stringValueOrNull(setting.UpdatedAt)
+	}
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/pro"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &SharedNetworkAcceptanceResource{}
+
+func NewSharedNetworkAcceptanceResource() resource.Resource {
+	return &SharedNetworkAcceptanceResource{}
+}
+
+// SharedNetworkAcceptanceResource accepts or rejects a network another
+// Chainlaunch instance shared with this one.
+type SharedNetworkAcceptanceResource struct {
+	client *Client
+}
+
+type SharedNetworkAcceptanceResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	ShareID      types.String `tfsdk:"share_id"`
+	Reject       types.Bool   `tfsdk:"reject"`
+	NetworkID    types.Int64  `tfsdk:"network_id"`
+	Status       types.String `tfsdk:"status"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	SharedBy     types.String `tfsdk:"shared_by"`
+}
+
+func (r *SharedNetworkAcceptanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_network_acceptance"
+}
+
+func (r *SharedNetworkAcceptanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Accepts a network shared with this Chainlaunch instance (Chainlaunch Pro), importing it locally, or rejects it. " +
+			"Use the chainlaunch_shared_networks data source to find the share. " +
+			"Note: Deleting this resource only removes it from Terraform state - the imported network is kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the acceptance, the share ID.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"share_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the share, from the chainlaunch_shared_networks data source.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reject": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Reject the share instead of accepting it. Default: false.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the network imported on this instance, for an accepted share.",
+			},
+			"status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of the share.",
+			},
+			"resource_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "The type of the shared network.",
+			},
+			"shared_by": schema.StringAttribute{
+				Computed:    true,
+				Description: "The instance that shared the network.",
+			},
+		},
+	}
+}
+
+func (r *SharedNetworkAcceptanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SharedNetworkAcceptanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SharedNetworkAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shareID := data.ShareID.ValueString()
+	data.ID = types.StringValue(shareID)
+	data.NetworkID = types.Int64Null()
+
+	if data.Reject.ValueBool() {
+		_, err := r.client.API.Pro.RejectSharedNetwork(pro.NewRejectSharedNetworkParamsWithContext(ctx).WithShareID(shareID), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reject shared network, got error: %s", err))
+			return
+		}
+	} else {
+		accepted, err := r.client.API.Pro.AcceptSharedNetwork(pro.NewAcceptSharedNetworkParamsWithContext(ctx).WithShareID(shareID), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to accept shared network, got error: %s", err))
+			return
+		}
+		data.NetworkID = types.Int64Value(accepted.Payload.NetworkID)
+	}
+
+	share, err := findSharedNetwork(ctx, r.client, shareID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared network, got error: %s", err))
+		return
+	}
+	data.Status = types.StringNull()
+	data.ResourceType = types.StringNull()
+	data.SharedBy = types.StringNull()
+	if share != nil {
+		setSharedNetworkAcceptance(&data, share)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SharedNetworkAcceptanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SharedNetworkAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	share, err := findSharedNetwork(ctx, r.client, data.ShareID.ValueString())
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shared network, got error: %s", err))
+		return
+	}
+	if share == nil {
+		// The share was revoked by the instance that sent it
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	setSharedNetworkAcceptance(&data, share)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SharedNetworkAcceptanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// A share is answered only once; every attribute that can be configured
+	// forces a replacement, so there is nothing to send here.
+	var data SharedNetworkAcceptanceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SharedNetworkAcceptanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// An accepted share imported the network, which is managed like any other
+	// network from then on, and a rejection cannot be undone, so deletion only
+	// removes the resource from Terraform state
+}
+
+// findSharedNetwork returns the network shared with this instance under
+// shareID, or nil when it is not listed.
+func findSharedNetwork(ctx context.Context, client *Client, shareID string) (*models.ProSharedNetworkResponse, error) {
+	list, err := client.API.Pro.ListSharedNetworks(pro.NewListSharedNetworksParamsWithContext(ctx), nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, share := range list.Payload.Networks {
+		if share != nil && share.ID == shareID {
+			return share, nil
+		}
+	}
+	return nil, nil
+}
+
+// setSharedNetworkAcceptance copies the share listed by the API into data.
+func setSharedNetworkAcceptance(data *SharedNetworkAcceptanceResourceModel, share *models.ProSharedNetworkResponse) {
+	data.Status = stringValueOrNull(share.Status)
+	data.ResourceType = stringValueOrNull(share.ResourceType)
+	data.SharedBy = stringValueOrNull(share.SharedBy)
+}
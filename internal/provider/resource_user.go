@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/client/users"
+	"github.com/chainlaunch/terraform-provider-chainlaunch/internal/generated/models"
+)
+
+var _ resource.Resource = &UserResource{}
+var _ resource.ResourceWithImportState = &UserResource{}
+var _ resource.ResourceWithValidateConfig = &UserResource{}
+
+func NewUserResource() resource.Resource {
+	return &UserResource{}
+}
+
+// UserResource manages a Chainlaunch user account and its role.
+type UserResource struct {
+	client *Client
+}
+
+type UserResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	Password    types.String `tfsdk:"password"`
+	Role        types.String `tfsdk:"role"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	LastLoginAt types.String `tfsdk:"last_login_at"`
+}
+
+// userRoles are the roles a user can be given.
+var userRoles = []models.AuthRole{
+	models.AuthRoleADMIN,
+	models.AuthRoleOPERATOR,
+	models.AuthRoleVIEWER,
+	models.AuthRoleCUSTOM,
+	models.AuthRoleMCP,
+}
+
+// validateUserRole checks the role value of a configuration.
+func validateUserRole(value types.String) error {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	for _, role := range userRoles {
+		if value.ValueString() == string(role) {
+			return nil
+		}
+	}
+	return fmt.Errorf("role must be one of ADMIN, OPERATOR, VIEWER, CUSTOM or MCP, got %q", value.ValueString())
+}
+
+func (r *UserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Chainlaunch user account, its role and its password. Managing users requires an ADMIN account. " +
+			"Do not also bind a role to this user with chainlaunch_role_binding: both manage the role of the user and would overwrite each other.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier of the user.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:    true,
+				Description: "The login name of the user.",
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				Description: "The password of the user, at least 8 characters. Changing it rotates the password, using the previous value as the current password. " +
+					"The API never returns passwords, so changes made outside Terraform are not detected.",
+			},
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "The role of the user: ADMIN, OPERATOR, VIEWER, CUSTOM or MCP.",
+			},
+			"permissions": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Codes of the permissions granted by the role of the user, as listed by chainlaunch_permissions.",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp when the user was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_login_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp of the last login of the user.",
+			},
+		},
+	}
+}
+
+func (r *UserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateUserRole(data.Role); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role"), "Invalid Role", err.Error())
+	}
+	if !data.Password.IsNull() && !data.Password.IsUnknown() && len(data.Password.ValueString()) < 8 {
+		resp.Diagnostics.AddAttributeError(path.Root("password"), "Invalid Password", "password must be at least 8 characters long.")
+	}
+}
+
+func (r *UserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role := models.AuthRole(data.Role.ValueString())
+	created, err := r.client.API.Users.CreateUser(users.NewCreateUserParamsWithContext(ctx).WithUser(&models.AuthCreateUserRequest{
+		Username: data.Username.ValueStringPointer(),
+		Password: data.Password.ValueStringPointer(),
+		Role:     &role,
+	}), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(strconv.FormatInt(created.Payload.ID, 10))
+	resp.Diagnostics.Append(r.setUser(ctx, &data, created.Payload)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	got, err := r.client.API.Users.GetUser(users.NewGetUserParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		if IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.setUser(ctx, &data, got.Payload)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UserResourceModel
+	var state UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	user := &models.AuthUserResponse{
+		ID:          id,
+		Username:    state.Username.ValueString(),
+		Role:        models.AuthRole(state.Role.ValueString()),
+		CreatedAt:   state.CreatedAt.ValueString(),
+		LastLoginAt: state.LastLoginAt.ValueString(),
+	}
+
+	// Each call is saved to state as soon as it succeeds, so that a failing
+	// one leaves the state matching the server. In particular, the password
+	// in state stays the current one until the rotation succeeds.
+	saved := state
+
+	if !data.Username.Equal(state.Username) {
+		updated, err := r.client.API.Users.UpdateUser(users.NewUpdateUserParamsWithContext(ctx).WithID(id).WithUser(&models.AuthUpdateUserRequest{
+			Username: data.Username.ValueString(),
+		}), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user, got error: %s", err))
+			return
+		}
+		user = updated.Payload
+
+		saved.Username = types.StringValue(user.Username)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &saved)...)
+	}
+
+	if !data.Role.Equal(state.Role) {
+		updated, err := r.client.API.Users.UpdateUserRole(users.NewUpdateUserRoleParamsWithContext(ctx).WithID(id).WithRequest(&models.AuthUpdateUserRequest{
+			Role: models.AuthRole(data.Role.ValueString()),
+		}), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user role, got error: %s", err))
+			return
+		}
+		user = updated.Payload
+
+		saved.Role = types.StringValue(string(user.Role))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &saved)...)
+	}
+
+	if !data.Password.Equal(state.Password) {
+		// The API asks for the current password, which is only known when
+		// Terraform set it; an imported user needs its password set once
+		// outside Terraform or ignore_changes on password.
+		if state.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Unknown Current Password",
+				"The current password of this user is not in the Terraform state, so it cannot be rotated. Add password to ignore_changes or set it through the Chainlaunch UI.",
+			)
+			return
+		}
+		_, err := r.client.API.Users.UpdateUserPassword(users.NewUpdateUserPasswordParamsWithContext(ctx).WithID(id).WithRequest(&models.AuthChangePasswordRequest{
+			CurrentPassword: state.Password.ValueStringPointer(),
+			NewPassword:     data.Password.ValueStringPointer(),
+		}), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate user password, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.setUser(ctx, &data, user)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := parseID(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", err.Error())
+		return
+	}
+
+	_, err = r.client.API.Users.DeleteUser(users.NewDeleteUserParamsWithContext(ctx).WithID(id), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// setUser copies the user returned by the API into data, along with the
+// permissions granted by its role.
+func (r *UserResource) setUser(ctx context.Context, data *UserResourceModel, user *models.AuthUserResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Username = types.StringValue(user.Username)
+	data.Role = types.StringValue(string(user.Role))
+	data.CreatedAt = stringValueOrNull(user.CreatedAt)
+	data.LastLoginAt = stringValueOrNull(user.LastLoginAt)
+
+	permissions, err := getPermissions(ctx, r.client)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read role permissions, got error: %s", err))
+		return diags
+	}
+	data.Permissions, diags = types.SetValueFrom(ctx, types.StringType, permissions.rolePermissionCodes(user.Role))
+	return diags
+}
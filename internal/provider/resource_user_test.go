@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestUserUpdateSavesCompletedSteps checks that a failing password rotation
+// keeps the role change made before it, and the password that is still current.
+func TestUserUpdateSavesCompletedSteps(t *testing.T) {
+	ctx := context.Background()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/users/7/role":
+			_, _ = w.Write([]byte(`{"id":7,"username":"alice","role":"VIEWER"}`))
+		case "/api/v1/users/7/password":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message":"password too weak"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	r := &UserResource{client: NewClient(server.URL, "", "test", "test")}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	user := func(password, role string, permissions types.Set) tftypes.Value {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := state.Set(ctx, &UserResourceModel{
+			ID:          types.StringValue("7"),
+			Username:    types.StringValue("alice"),
+			Password:    types.StringValue(password),
+			Role:        types.StringValue(role),
+			Permissions: permissions,
+			CreatedAt:   types.StringValue("2026-01-01T00:00:00Z"),
+			LastLoginAt: types.StringNull(),
+		})
+		if diags.HasError() {
+			t.Fatalf("unable to build user: %v", diags)
+		}
+		return state.Raw
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: user("old-password", "OPERATOR", types.SetValueMust(types.StringType, []attr.Value{}))}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: user("new-password", "VIEWER", types.SetUnknown(types.StringType))}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected the password rotation to fail")
+	}
+
+	var got UserResourceModel
+	if diags := resp.State.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unable to read state: %v", diags)
+	}
+	if got.Role.ValueString() != "VIEWER" {
+		t.Errorf("expected the role change to be saved, got %s", got.Role)
+	}
+	if got.Password.ValueString() != "old-password" {
+		t.Errorf("expected the current password to stay in state, got %s", got.Password)
+	}
+}
@@ -0,0 +1,15 @@
+package provider
+
+import "time"
+
+// Defaults for resources with a timeouts block, used when the configuration
+// does not set a value for the operation.
+const (
+	defaultCreateTimeout = 10 * time.Minute
+	defaultUpdateTimeout = 10 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+
+	// statusPollInterval is the delay between status checks while waiting for
+	// a node, key provider or deployment to become ready.
+	statusPollInterval = 2 * time.Second
+)
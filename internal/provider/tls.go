@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportSettings configures the connection to the Chainlaunch API
+type TransportSettings struct {
+	// CACertPEM holds PEM encoded CA certificates trusted in addition to the system pool
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM hold the client certificate and key for mutual TLS
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// InsecureSkipVerify disables verification of the server certificate
+	InsecureSkipVerify bool
+	// ProxyURL is the proxy for API requests; when empty the HTTP_PROXY,
+	// HTTPS_PROXY and NO_PROXY environment variables apply
+	ProxyURL string
+}
+
+// ConfigureTransport replaces the base transport of the client, which is used
+// by both DoRequest and the generated API client, with one built from s.
+func (c *Client) ConfigureTransport(s TransportSettings) error {
+	base, err := newBaseTransport(s)
+	if err != nil {
+		return err
+	}
+	t, ok := c.HTTPClient.Transport.(*apiTransport)
+	if !ok {
+		return errors.New("the client transport cannot be configured")
+	}
+	t.base = base
+	return nil
+}
+
+// newBaseTransport clones http.DefaultTransport and applies the TLS and proxy settings.
+func newBaseTransport(s TransportSettings) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: s.InsecureSkipVerify, //nolint:gosec // explicitly requested through insecure_skip_verify
+	}
+
+	if s.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(s.CACertPEM)) {
+			return nil, errors.New("the CA certificate does not contain any valid PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (s.ClientCertPEM == "") != (s.ClientKeyPEM == "") {
+		return nil, errors.New("a client certificate and a client key must be provided together")
+	}
+	if s.ClientCertPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(s.ClientCertPEM), []byte(s.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if s.ProxyURL != "" {
+		proxy, err := url.Parse(s.ProxyURL)
+		if err != nil || proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: expected a URL such as http://proxy.example.com:3128", s.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// serverCAPEM returns the certificate of a TLS test server in PEM form.
+func serverCAPEM(server *httptest.Server) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// newClientCertificate generates a self-signed client certificate and key in PEM form.
+func newClientCertificate(t *testing.T) (certPEM, keyPEM string, cert *x509.Certificate) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+	return certPEM, keyPEM, cert
+}
+
+func newTLSTestClient(t *testing.T, url string, settings TransportSettings) *Client {
+	t.Helper()
+	client := newTestClient(url)
+	client.MaxRetries = 0
+	if err := client.ConfigureTransport(settings); err != nil {
+		t.Fatalf("unexpected error configuring the transport: %s", err)
+	}
+	return client
+}
+
+func TestConfigureTransportTrustsCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	if _, err := newTLSTestClient(t, server.URL, TransportSettings{}).DoRequest(context.Background(), "GET", "/nodes", nil); err == nil {
+		t.Fatal("expected the server certificate to be rejected without its CA")
+	}
+
+	client := newTLSTestClient(t, server.URL, TransportSettings{CACertPEM: serverCAPEM(server)})
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("expected the CA certificate to be trusted, got: %s", err)
+	}
+
+	client = newTLSTestClient(t, server.URL, TransportSettings{InsecureSkipVerify: true})
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("expected verification to be skipped, got: %s", err)
+	}
+}
+
+func TestConfigureTransportPresentsClientCertificate(t *testing.T) {
+	certPEM, keyPEM, cert := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) == 0 || r.TLS.PeerCertificates[0].Subject.CommonName != "terraform" {
+			t.Error("expected the client certificate to be presented")
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	client := newTLSTestClient(t, server.URL, TransportSettings{
+		CACertPEM:     serverCAPEM(server),
+		ClientCertPEM: certPEM,
+		ClientKeyPEM:  keyPEM,
+	})
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("expected mutual TLS to succeed, got: %s", err)
+	}
+}
+
+func TestConfigureTransportUsesProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+
+	client := newTLSTestClient(t, "http://chainlaunch.internal:8100", TransportSettings{ProxyURL: proxy.URL})
+	if _, err := client.DoRequest(context.Background(), "GET", "/nodes", nil); err != nil {
+		t.Fatalf("expected the request to go through the proxy, got: %s", err)
+	}
+	if proxied != "http://chainlaunch.internal:8100/api/v1/nodes" {
+		t.Errorf("unexpected proxied URL %q", proxied)
+	}
+}
+
+func TestConfigureTransportRejectsInvalidSettings(t *testing.T) {
+	certPEM, keyPEM, _ := newClientCertificate(t)
+	tests := map[string]TransportSettings{
+		"invalid CA":              {CACertPEM: "not a certificate"},
+		"certificate without key": {ClientCertPEM: certPEM},
+		"key without certificate": {ClientKeyPEM: keyPEM},
+		"mismatched key":          {ClientCertPEM: certPEM, ClientKeyPEM: "not a key"},
+		"invalid proxy":           {ProxyURL: "proxy.example.com"},
+	}
+	for name, settings := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := NewClient("https://localhost", "", "", "").ConfigureTransport(settings); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// POST endpoints that can safely be repeated when the outcome of an attempt is unknown
+var idempotentPOSTSuffixes = []string{
+	"/start",
+	"/stop",
+	"/restart",
+	"/resume",
+	"/undeploy",
+	"/sync-external-nodes",
+}
+
+// apiTransport is the http.RoundTripper shared by DoRequest and the generated
+// API client, so every resource authenticates the same way. It adds the
+// client's credentials to each request, bounds each attempt by the client's
+// request timeout and retries transient failures (429, 502, 503, 504 and
+// connection errors) with exponential backoff and jitter, honouring
+// Retry-After. Requests whose outcome is unknown are only retried when
+// repeating them is safe. Cancelling the request context aborts both the
+// in-flight attempt and any pending retry.
+type apiTransport struct {
+	client *Client
+	base   http.RoundTripper
+}
+
+func (t *apiTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c := t.client
+	ctx := req.Context()
+	logCtx := newLogContext(ctx)
+	method, path := req.Method, req.URL.Path
+	logBodies := logBodiesFor(path)
+
+	// Buffer the body so that it can be replayed on retries
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+	}
+
+	for attempt, relogin := 0, false; ; attempt++ {
+		attemptReq, cancel := t.newAttempt(req, body)
+		session, err := t.authenticate(attemptReq)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		fields := map[string]interface{}{
+			"method":          method,
+			"url":             req.URL.String(),
+			"attempt":         attempt + 1,
+			"request_headers": redactHeaders(attemptReq.Header),
+		}
+		if logBodies {
+			fields["request_body"] = redactBody(body)
+		}
+		tflog.SubsystemDebug(logCtx, logSubsystem, "Sending Chainlaunch API request", fields)
+
+		start := time.Now()
+		resp, err := t.base.RoundTrip(attemptReq)
+		if err != nil {
+			cancel()
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			if attempt < c.MaxRetries && shouldRetryError(method, path, err) {
+				wait := c.retryWait(attempt, nil)
+				tflog.SubsystemWarn(logCtx, logSubsystem, "Chainlaunch API request failed, retrying", map[string]interface{}{
+					"method": method,
+					"url":    req.URL.String(),
+					"wait":   wait.String(),
+					"error":  err.Error(),
+				})
+				if err := sleepWithContext(ctx, wait); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			return nil, err
+		}
+
+		fields = map[string]interface{}{
+			"method":           method,
+			"url":              req.URL.String(),
+			"status":           resp.StatusCode,
+			"duration":         time.Since(start).String(),
+			"response_headers": redactHeaders(resp.Header),
+		}
+		if logBodies {
+			respBody, err := io.ReadAll(resp.Body)
+			_ = resp.Body.Close()
+			if err != nil {
+				cancel()
+				return nil, fmt.Errorf("error reading response body: %w", err)
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(respBody))
+			fields["response_body"] = redactBody(respBody)
+		}
+		tflog.SubsystemDebug(logCtx, logSubsystem, "Received Chainlaunch API response", fields)
+
+		// An expired session is answered with 401: log in again and replay the
+		// request once. The replay does not count as a retry.
+		if resp.StatusCode == http.StatusUnauthorized && !relogin && t.invalidateSession(session) {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+			cancel()
+			tflog.SubsystemDebug(logCtx, logSubsystem, "Chainlaunch API session rejected, logging in again")
+			relogin = true
+			attempt--
+			continue
+		}
+
+		if attempt < c.MaxRetries && shouldRetryStatus(method, path, resp.StatusCode) {
+			wait := c.retryWait(attempt, resp)
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+			cancel()
+			tflog.SubsystemWarn(logCtx, logSubsystem, "Chainlaunch API request returned a transient error, retrying", map[string]interface{}{
+				"method": method,
+				"url":    req.URL.String(),
+				"status": resp.StatusCode,
+				"wait":   wait.String(),
+			})
+			if err := sleepWithContext(ctx, wait); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+		return resp, nil
+	}
+}
+
+// newAttempt clones req for a single attempt with a fresh copy of the body
+// and the per-request timeout. A deadline set by the caller (a resource
+// timeouts block) still applies when it comes first. The returned cancel
+// function releases the attempt's context.
+func (t *apiTransport) newAttempt(req *http.Request, body []byte) (*http.Request, context.CancelFunc) {
+	c := t.client
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if c.RequestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.RequestTimeout)
+	}
+
+	attemptReq := req.Clone(ctx)
+	if body != nil {
+		attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		attemptReq.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		attemptReq.ContentLength = int64(len(body))
+	}
+
+	return attemptReq, cancel
+}
+
+// cancelOnClose releases an attempt's context once the response body is closed,
+// so that the request timeout also covers reading the body.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// sleepWithContext waits for d, returning early with the context error if ctx is done first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// isIdempotentRequest reports whether a request can be repeated without side effects.
+func isIdempotentRequest(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		for _, suffix := range idempotentPOSTSuffixes {
+			if strings.HasSuffix(path, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// shouldRetryStatus decides whether a response status is worth retrying.
+// 429 and 503 mean the request was not processed, so any method can be retried.
+// After 502 and 504 the request may have been applied, so only safe requests are retried.
+func shouldRetryStatus(method, path string, statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return isIdempotentRequest(method, path)
+	}
+	return false
+}
+
+// shouldRetryError decides whether a transport error is worth retrying. A refused
+// connection never reached the server; other errors (resets, timeouts) leave the
+// outcome unknown.
+func shouldRetryError(method, path string, err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return isIdempotentRequest(method, path)
+}
+
+// retryWait returns how long to wait before the next attempt: the server's
+// Retry-After when present, otherwise exponential backoff with jitter.
+// The result never exceeds RetryMaxWait.
+func (c *Client) retryWait(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > c.RetryMaxWait {
+				return c.RetryMaxWait
+			}
+			return wait
+		}
+	}
+	return retryBackoff(attempt, c.RetryWaitMin, c.RetryMaxWait)
+}
+
+// retryBackoff doubles minWait for every attempt, capped at maxWait, and picks a
+// random duration in the upper half of that window.
+func retryBackoff(attempt int, minWait, maxWait time.Duration) time.Duration {
+	wait := minWait
+	for i := 0; i < attempt && wait < maxWait; i++ {
+		wait *= 2
+	}
+	if wait > maxWait {
+		wait = maxWait
+	}
+	if wait <= 1 {
+		return wait
+	}
+	half := wait / 2
+	return half + time.Duration(rand.Int64N(int64(wait-half)+1))
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}